@@ -0,0 +1,140 @@
+// Package cache provides an in-process LRU cache of serialized responses,
+// bounded by the total size of the cached values.
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// entryOverhead approximates the bookkeeping memory of one entry (list
+// element, map slot and key header) so that many tiny values still count
+// against the budget
+const entryOverhead = 128
+
+// Stats reports cache usage counters
+type Stats struct {
+	Hits      uint64  `json:"hits"`
+	Misses    uint64  `json:"misses"`
+	HitRate   float64 `json:"hit_rate"`
+	Evictions uint64  `json:"evictions"`
+	Purges    uint64  `json:"purges"`
+	Entries   int     `json:"entries"`
+	Bytes     int64   `json:"bytes"`
+	MaxBytes  int64   `json:"max_bytes"`
+}
+
+type entry struct {
+	key   string
+	value []byte
+}
+
+// LRU is a least-recently-used cache of byte slices bounded by memory.
+// It is safe for concurrent use.
+type LRU struct {
+	mu        sync.Mutex
+	maxBytes  int64
+	bytes     int64
+	ll        *list.List
+	items     map[string]*list.Element
+	hits      uint64
+	misses    uint64
+	evictions uint64
+	purges    uint64
+}
+
+// NewLRU creates a cache holding at most maxBytes of keys and values
+func NewLRU(maxBytes int64) *LRU {
+	return &LRU{
+		maxBytes: maxBytes,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func entrySize(key string, value []byte) int64 {
+	return int64(len(key) + len(value) + entryOverhead)
+}
+
+// Get returns the value stored under key and marks it as recently used
+func (c *LRU) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.hits++
+		return el.Value.(*entry).value, true
+	}
+	c.misses++
+	return nil, false
+}
+
+// Set stores value under key, evicting least recently used entries as needed.
+// Values larger than the whole cache are not stored.
+func (c *LRU) Set(key string, value []byte) {
+	size := entrySize(key, value)
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		old := el.Value.(*entry)
+		c.bytes += size - entrySize(old.key, old.value)
+		old.value = value
+		c.ll.MoveToFront(el)
+	} else {
+		c.items[key] = c.ll.PushFront(&entry{key: key, value: value})
+		c.bytes += size
+	}
+
+	for c.bytes > c.maxBytes {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.removeElement(oldest)
+		c.evictions++
+	}
+}
+
+// Purge removes all entries
+func (c *LRU) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+	c.bytes = 0
+	c.purges++
+}
+
+// Stats returns a snapshot of the cache counters
+func (c *LRU) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Purges:    c.purges,
+		Entries:   c.ll.Len(),
+		Bytes:     c.bytes,
+		MaxBytes:  c.maxBytes,
+	}
+	if total := c.hits + c.misses; total > 0 {
+		stats.HitRate = float64(c.hits) / float64(total)
+	}
+	return stats
+}
+
+func (c *LRU) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	e := el.Value.(*entry)
+	delete(c.items, e.key)
+	c.bytes -= entrySize(e.key, e.value)
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	value := []byte(strings.Repeat("x", 100))
+	size := entrySize("a", value)
+	c := NewLRU(3 * size)
+
+	c.Set("a", value)
+	c.Set("b", value)
+	c.Set("c", value)
+
+	// Touch "a" so that "b" becomes the least recently used entry
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("Expected a to be cached")
+	}
+	c.Set("d", value)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c", "d"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("Expected %s to be cached", key)
+		}
+	}
+
+	stats := c.Stats()
+	if stats.Entries != 3 || stats.Evictions != 1 || stats.Bytes != 3*size {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+	if stats.Hits != 4 || stats.Misses != 1 {
+		t.Errorf("Expected 4 hits and 1 miss, got %+v", stats)
+	}
+}
+
+func TestLRUPurgeAndOversizedValues(t *testing.T) {
+	c := NewLRU(1024)
+	c.Set("big", make([]byte, 2048))
+	if _, ok := c.Get("big"); ok {
+		t.Error("Expected values larger than the cache not to be stored")
+	}
+
+	c.Set("small", []byte("ok"))
+	c.Purge()
+	if _, ok := c.Get("small"); ok {
+		t.Error("Expected purge to remove all entries")
+	}
+	if stats := c.Stats(); stats.Entries != 0 || stats.Bytes != 0 || stats.Purges != 1 {
+		t.Errorf("Unexpected stats after purge %+v", stats)
+	}
+}
@@ -0,0 +1,92 @@
+// Command export writes the administrative dataset as CSV, TSV or XLSX, the
+// offline equivalent of GET /api/v1/export.
+//
+//	go run ./cmd/export -format xlsx -dataset wards_with_province -o wards.xlsx
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"vietnam-admin-api/export"
+	"vietnam-admin-api/services"
+)
+
+func main() {
+	dataPath := flag.String("data", "./data", "directory containing province.json and ward.json")
+	formatName := flag.String("format", "csv", "output format: csv, tsv or xlsx")
+	datasetName := flag.String("dataset", "wards_with_province", "table to export: provinces, wards or wards_with_province")
+	search := flag.String("search", "", "only export records matching this search term")
+	typeFilter := flag.String("type", "", "only export records of this type")
+	provinceCode := flag.String("province", "", "only export wards of this province code")
+	region := flag.String("region", "", "only export records of this region or socio-economic region, e.g. mien-bac")
+	sortParam := flag.String("sort", "name", "sort order: name, code, type or province, prefixed with - for descending")
+	bom := flag.Bool("bom", false, "prefix CSV and TSV output with a UTF-8 byte order mark for Excel")
+	output := flag.String("o", "", "output file (default: standard output)")
+	flag.Parse()
+
+	if err := run(*dataPath, *formatName, *datasetName, *sortParam, *output, *bom, export.Query{
+		Search:       *search,
+		Type:         *typeFilter,
+		ProvinceCode: *provinceCode,
+		Region:       *region,
+	}); err != nil {
+		fmt.Fprintln(os.Stderr, "export:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dataPath, formatName, datasetName, sortParam, output string, bom bool, query export.Query) error {
+	format, err := export.ParseFormat(formatName)
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, formatName)
+	}
+	if query.Dataset, err = export.ParseDataset(datasetName); err != nil {
+		return fmt.Errorf("%w: %s", err, datasetName)
+	}
+	if query.Sort, err = services.ParseSortOrder(sortParam); err != nil {
+		return fmt.Errorf("%w: %s", err, sortParam)
+	}
+
+	dataService := services.NewDataService(dataPath)
+	if err := dataService.LoadData(); err != nil {
+		return err
+	}
+	if query.Region != "" && !dataService.IsRegion(query.Region) {
+		return fmt.Errorf("unknown region: %s (expected one of %s)", query.Region, strings.Join(dataService.GetRegions(), ", "))
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	buffered := bufio.NewWriter(out)
+
+	rw, err := export.NewRowWriter(buffered, format, export.Options{BOM: bom, SheetName: string(query.Dataset)})
+	if err != nil {
+		return err
+	}
+	rows, err := export.Write(context.Background(), dataService, rw, query)
+	if err != nil {
+		return err
+	}
+	if err := rw.Close(); err != nil {
+		return err
+	}
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "exported %d %s\n", rows, query.Dataset)
+	return nil
+}
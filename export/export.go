@@ -0,0 +1,112 @@
+// Package export writes the administrative dataset as spreadsheet-friendly
+// tables (CSV, TSV and XLSX).
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrUnknownFormat is returned by ParseFormat for unsupported formats
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Format is an export file format
+type Format string
+
+// Supported export formats
+const (
+	FormatCSV  Format = "csv"
+	FormatTSV  Format = "tsv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormat parses a format name, defaulting to CSV when empty
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatTSV:
+		return FormatTSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatTSV:
+		return "text/tab-separated-values; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// Options tune the output of a RowWriter
+type Options struct {
+	// BOM prefixes CSV and TSV output with a UTF-8 byte order mark so that
+	// Excel detects the encoding of Vietnamese text
+	BOM bool
+	// SheetName names the worksheet of XLSX output
+	SheetName string
+}
+
+// RowWriter writes rows of a table. Close must be called to complete the
+// output.
+type RowWriter interface {
+	Write(row []string) error
+	Close() error
+}
+
+// NewRowWriter returns a RowWriter producing the given format on w
+func NewRowWriter(w io.Writer, format Format, opts Options) (RowWriter, error) {
+	switch format {
+	case FormatXLSX:
+		return newXLSXWriter(w, opts.SheetName)
+	case FormatCSV, FormatTSV:
+		if opts.BOM {
+			if _, err := io.WriteString(w, "\ufeff"); err != nil {
+				return nil, err
+			}
+		}
+		cw := csv.NewWriter(w)
+		if format == FormatTSV {
+			cw.Comma = '\t'
+		}
+		return &csvWriter{w: cw}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+// csvWriter adapts encoding/csv, which quotes fields containing separators,
+// quotes or line breaks
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (cw *csvWriter) Write(row []string) error {
+	escaped := make([]string, len(row))
+	for i, field := range row {
+		escaped[i] = escapeFormula(field)
+	}
+	return cw.w.Write(escaped)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// escapeFormula prefixes fields that spreadsheets would evaluate as formulas
+// with a quote, so exported text is always shown as text
+func escapeFormula(field string) string {
+	if field != "" && strings.ContainsRune("=+-@\t\r", rune(field[0])) {
+		return "'" + field
+	}
+	return field
+}
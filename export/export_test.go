@@ -0,0 +1,89 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCSVEscaping(t *testing.T) {
+	var buf bytes.Buffer
+	rw, err := NewRowWriter(&buf, FormatCSV, Options{BOM: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := [][]string{
+		{"Phường Bến Thành", `Quận "1", TP.HCM`},
+		{"=SUM(A1)", "Đắk Lắk\nTây Nguyên"},
+	}
+	for _, row := range rows {
+		rw.Write(row)
+	}
+	if err := rw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(buf.String(), "\ufeff") {
+		t.Fatal("Expected output to start with a UTF-8 BOM")
+	}
+	got, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), "\ufeff"))).ReadAll()
+	if err != nil {
+		t.Fatalf("Output is not valid CSV: %v", err)
+	}
+	if got[0][1] != `Quận "1", TP.HCM` || got[1][1] != "Đắk Lắk\nTây Nguyên" {
+		t.Errorf("Vietnamese text was not preserved: %q", got)
+	}
+	if got[1][0] != "'=SUM(A1)" {
+		t.Errorf("Expected formula to be neutralized, got %q", got[1][0])
+	}
+}
+
+func TestTSVSeparator(t *testing.T) {
+	var buf bytes.Buffer
+	rw, _ := NewRowWriter(&buf, FormatTSV, Options{})
+	rw.Write([]string{"01", "Hà Nội"})
+	rw.Close()
+
+	if buf.String() != "01\tHà Nội\n" {
+		t.Errorf("Unexpected TSV output %q", buf.String())
+	}
+}
+
+func TestXLSXIsWellFormed(t *testing.T) {
+	var buf bytes.Buffer
+	rw, err := NewRowWriter(&buf, FormatXLSX, Options{SheetName: "wards"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rw.Write([]string{"code", "name"})
+	rw.Write([]string{"00004", "Ba Đình <&> Hà Nội"})
+	if err := rw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Output is not a zip archive: %v", err)
+	}
+	for _, f := range zr.File {
+		r, _ := f.Open()
+		data, _ := io.ReadAll(r)
+		r.Close()
+
+		decoder := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := decoder.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well-formed XML: %v", f.Name, err)
+			}
+		}
+		if f.Name == "xl/worksheets/sheet1.xml" && !bytes.Contains(data, []byte("Ba Đình &lt;&amp;&gt; Hà Nội")) {
+			t.Errorf("Expected escaped cell text in worksheet")
+		}
+	}
+}
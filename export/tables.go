@@ -0,0 +1,101 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/services"
+)
+
+// ErrUnknownDataset is returned by ParseDataset for unsupported datasets
+var ErrUnknownDataset = errors.New("unknown export dataset")
+
+// Dataset names an exportable table
+type Dataset string
+
+// Exportable tables
+const (
+	DatasetProvinces         Dataset = "provinces"
+	DatasetWards             Dataset = "wards"
+	DatasetWardsWithProvince Dataset = "wards_with_province"
+)
+
+// ParseDataset parses a dataset name, defaulting to wards joined with their
+// provinces when empty
+func ParseDataset(s string) (Dataset, error) {
+	switch Dataset(strings.ToLower(strings.TrimSpace(s))) {
+	case "", DatasetWardsWithProvince:
+		return DatasetWardsWithProvince, nil
+	case DatasetProvinces:
+		return DatasetProvinces, nil
+	case DatasetWards:
+		return DatasetWards, nil
+	}
+	return "", ErrUnknownDataset
+}
+
+// Query selects the rows of an export. The filters are those of the list
+// endpoints; ProvinceCode does not apply to the provinces dataset.
+type Query struct {
+	Dataset      Dataset
+	Search       string
+	Type         string
+	ProvinceCode string
+	Region       string
+	Sort         services.SortOrder
+}
+
+// Column headers of each dataset
+var (
+	ProvinceHeader         = []string{"code", "name", "slug", "type", "name_with_type", "name_en", "name_ascii", "name_with_type_en", "region", "economic_region"}
+	WardHeader             = []string{"code", "name", "slug", "type", "name_with_type", "path", "path_with_type", "parent_code", "name_en", "name_ascii", "name_with_type_en"}
+	WardWithProvinceHeader = append(append([]string{}, WardHeader...), "province_name", "province_type", "province_name_with_type")
+)
+
+// Write writes the header and rows selected by q and returns the number of
+// data rows written. The caller closes rw.
+func Write(ctx context.Context, ds *services.DataService, rw RowWriter, q Query) (int, error) {
+	if q.Dataset == DatasetProvinces {
+		provinces, _ := ds.ListProvinces(ctx, q.Search, q.Type, q.Region, q.Sort)
+		if err := rw.Write(ProvinceHeader); err != nil {
+			return 0, err
+		}
+		for i, p := range provinces {
+			if err := rw.Write([]string{p.Code, p.Name, p.Slug, p.Type, p.NameWithType, p.NameEn, p.NameASCII, p.NameWithTypeEn,
+				p.Region, p.EconomicRegion}); err != nil {
+				return i, err
+			}
+		}
+		return len(provinces), nil
+	}
+
+	wards, _ := ds.ListWards(ctx, q.Search, q.Type, q.ProvinceCode, q.Region, q.Sort)
+	header := WardHeader
+	var provinces map[string]models.Province
+	if q.Dataset == DatasetWardsWithProvince {
+		header = WardWithProvinceHeader
+		codes := make([]string, 0, len(wards))
+		for _, w := range wards {
+			codes = append(codes, w.ParentCode)
+		}
+		provinces = ds.GetProvincesByCode(ctx, codes)
+	}
+
+	if err := rw.Write(header); err != nil {
+		return 0, err
+	}
+	for i, w := range wards {
+		row := []string{w.Code, w.Name, w.Slug, w.Type, w.NameWithType, w.Path, w.PathWithType, w.ParentCode,
+			w.NameEn, w.NameASCII, w.NameWithTypeEn}
+		if provinces != nil {
+			p := provinces[w.ParentCode]
+			row = append(row, p.Name, p.Type, p.NameWithType)
+		}
+		if err := rw.Write(row); err != nil {
+			return i, err
+		}
+	}
+	return len(wards), nil
+}
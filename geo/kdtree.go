@@ -0,0 +1,199 @@
+// Package geo provides the spatial indexes used to locate administrative
+// units from coordinates.
+package geo
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// EarthRadiusKm is the mean radius of the Earth
+const EarthRadiusKm = 6371.0088
+
+// Point is a WGS 84 coordinate
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid reports whether the point is within the latitude and longitude ranges
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// DistanceKm returns the great-circle distance between two points
+func DistanceKm(a, b Point) float64 {
+	return chordToKm(chordSquared(toVector(a), toVector(b)))
+}
+
+// Item is an indexed point and the identifier it locates
+type Item struct {
+	ID    string
+	Point Point
+}
+
+// Neighbor is an item found near a point and its distance from it
+type Neighbor struct {
+	ID         string
+	DistanceKm float64
+}
+
+// KDTree indexes points for nearest-neighbor queries. Points are stored as
+// unit vectors, where the straight-line distance grows with the
+// great-circle distance, so the search is exact across the antimeridian and
+// near the poles. A KDTree is immutable and safe for concurrent use.
+type KDTree struct {
+	nodes []kdNode
+	root  int
+}
+
+type kdNode struct {
+	id          string
+	v           vector
+	axis        int
+	left, right int
+}
+
+// vector is a point on the unit sphere
+type vector [3]float64
+
+// NewKDTree builds a balanced tree of the items
+func NewKDTree(items []Item) *KDTree {
+	nodes := make([]kdNode, len(items))
+	for i, item := range items {
+		nodes[i] = kdNode{id: item.ID, v: toVector(item.Point), left: -1, right: -1}
+	}
+	t := &KDTree{nodes: nodes}
+	t.root = t.build(0, len(nodes), 0)
+	return t
+}
+
+// build arranges nodes[lo:hi] around their median on the axis and returns
+// the index of the median, or -1 for an empty range
+func (t *KDTree) build(lo, hi, depth int) int {
+	if lo >= hi {
+		return -1
+	}
+	axis := depth % 3
+	part := t.nodes[lo:hi]
+	sort.Slice(part, func(i, j int) bool {
+		if part[i].v[axis] != part[j].v[axis] {
+			return part[i].v[axis] < part[j].v[axis]
+		}
+		return part[i].id < part[j].id
+	})
+	mid := lo + (hi-lo)/2
+	t.nodes[mid].axis = axis
+	t.nodes[mid].left = t.build(lo, mid, depth+1)
+	t.nodes[mid].right = t.build(mid+1, hi, depth+1)
+	return mid
+}
+
+// Len returns the number of indexed points; a nil tree is empty
+func (t *KDTree) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.nodes)
+}
+
+// Nearest returns up to k items closest to p, nearest first. Items at the
+// same distance are ordered by ID.
+func (t *KDTree) Nearest(p Point, k int) []Neighbor {
+	if k <= 0 || t.Len() == 0 {
+		return []Neighbor{}
+	}
+	q := toVector(p)
+	best := &neighborHeap{}
+	t.search(t.root, q, k, best)
+
+	neighbors := make([]Neighbor, best.Len())
+	for i := len(neighbors) - 1; i >= 0; i-- {
+		c := heap.Pop(best).(candidate)
+		neighbors[i] = Neighbor{ID: t.nodes[c.node].id, DistanceKm: chordToKm(c.dist)}
+	}
+	return neighbors
+}
+
+func (t *KDTree) search(i int, q vector, k int, best *neighborHeap) {
+	if i < 0 {
+		return
+	}
+	n := &t.nodes[i]
+	best.offer(candidate{node: i, dist: chordSquared(q, n.v), id: n.id}, k)
+
+	diff := q[n.axis] - n.v[n.axis]
+	near, far := n.left, n.right
+	if diff > 0 {
+		near, far = far, near
+	}
+	t.search(near, q, k, best)
+	// The far side can only hold closer points if the splitting plane is
+	// nearer than the current k-th neighbor
+	if best.Len() < k || diff*diff <= (*best)[0].dist {
+		t.search(far, q, k, best)
+	}
+}
+
+// candidate is a node with its squared chord distance to the query
+type candidate struct {
+	node int
+	dist float64
+	id   string
+}
+
+// farther orders candidates by distance, then by ID
+func (c candidate) farther(o candidate) bool {
+	if c.dist != o.dist {
+		return c.dist > o.dist
+	}
+	return c.id > o.id
+}
+
+// neighborHeap is a max-heap of the best candidates found so far
+type neighborHeap []candidate
+
+func (h neighborHeap) Len() int            { return len(h) }
+func (h neighborHeap) Less(i, j int) bool  { return h[i].farther(h[j]) }
+func (h neighborHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *neighborHeap) Push(x interface{}) { *h = append(*h, x.(candidate)) }
+func (h *neighborHeap) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+// offer keeps c if it is among the k nearest candidates
+func (h *neighborHeap) offer(c candidate, k int) {
+	if h.Len() < k {
+		heap.Push(h, c)
+		return
+	}
+	if (*h)[0].farther(c) {
+		(*h)[0] = c
+		heap.Fix(h, 0)
+	}
+}
+
+func toVector(p Point) vector {
+	lat := p.Lat * math.Pi / 180
+	lng := p.Lng * math.Pi / 180
+	return vector{math.Cos(lat) * math.Cos(lng), math.Cos(lat) * math.Sin(lng), math.Sin(lat)}
+}
+
+func chordSquared(a, b vector) float64 {
+	dx, dy, dz := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+// chordToKm converts a squared chord length on the unit sphere to the
+// great-circle distance
+func chordToKm(chord2 float64) float64 {
+	half := math.Sqrt(chord2) / 2
+	if half > 1 {
+		half = 1
+	}
+	return 2 * math.Asin(half) * EarthRadiusKm
+}
@@ -0,0 +1,72 @@
+package geo
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestDistanceKm(t *testing.T) {
+	hanoi := Point{Lat: 21.0285, Lng: 105.8542}
+	saigon := Point{Lat: 10.7769, Lng: 106.7009}
+	// About 1,140 km as the crow flies
+	if d := DistanceKm(hanoi, saigon); math.Abs(d-1140) > 10 {
+		t.Errorf("Expected about 1140 km between Hà Nội and Hồ Chí Minh, got %.1f", d)
+	}
+	if d := DistanceKm(hanoi, hanoi); d != 0 {
+		t.Errorf("Expected no distance from a point to itself, got %f", d)
+	}
+}
+
+func TestKDTreeNearestMatchesLinearScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	items := make([]Item, 2000)
+	for i := range items {
+		items[i] = Item{
+			ID:    strconv.Itoa(i),
+			Point: Point{Lat: 8 + rng.Float64()*15, Lng: 102 + rng.Float64()*8},
+		}
+	}
+	tree := NewKDTree(items)
+	if tree.Len() != len(items) {
+		t.Fatalf("Expected %d points, got %d", len(items), tree.Len())
+	}
+
+	for q := 0; q < 200; q++ {
+		p := Point{Lat: 7 + rng.Float64()*17, Lng: 101 + rng.Float64()*10}
+		got := tree.Nearest(p, 5)
+
+		want := make([]Neighbor, len(items))
+		for i, item := range items {
+			want[i] = Neighbor{ID: item.ID, DistanceKm: DistanceKm(p, item.Point)}
+		}
+		sort.Slice(want, func(i, j int) bool { return want[i].DistanceKm < want[j].DistanceKm })
+
+		if len(got) != 5 {
+			t.Fatalf("Expected 5 neighbors, got %d", len(got))
+		}
+		for i := range got {
+			if got[i].ID != want[i].ID || math.Abs(got[i].DistanceKm-want[i].DistanceKm) > 1e-6 {
+				t.Fatalf("Query %v: neighbor %d is %+v, want %+v", p, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestKDTreeAcrossAntimeridian(t *testing.T) {
+	tree := NewKDTree([]Item{
+		{ID: "east", Point: Point{Lat: 0, Lng: 179.9}},
+		{ID: "west", Point: Point{Lat: 0, Lng: -170}},
+	})
+	if got := tree.Nearest(Point{Lat: 0, Lng: -179.9}, 1); got[0].ID != "east" {
+		t.Errorf("Expected the point across the antimeridian, got %+v", got)
+	}
+}
+
+func TestKDTreeEmpty(t *testing.T) {
+	if got := NewKDTree(nil).Nearest(Point{}, 3); len(got) != 0 {
+		t.Errorf("Expected no neighbors, got %+v", got)
+	}
+}
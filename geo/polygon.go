@@ -0,0 +1,210 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Ring is a closed line whose first and last points are equal
+type Ring []Point
+
+// Polygon is an outer ring followed by the rings of its holes
+type Polygon []Ring
+
+// MultiPolygon is a geometry made of one or more polygons
+type MultiPolygon []Polygon
+
+// Box is an axis-aligned bounding box in degrees
+type Box struct {
+	MinLat, MinLng, MaxLat, MaxLng float64
+}
+
+// Contains reports whether p lies within the box, edges included
+func (b Box) Contains(p Point) bool {
+	return p.Lat >= b.MinLat && p.Lat <= b.MaxLat && p.Lng >= b.MinLng && p.Lng <= b.MaxLng
+}
+
+// extend returns the smallest box containing b and o
+func (b Box) extend(o Box) Box {
+	return Box{
+		MinLat: math.Min(b.MinLat, o.MinLat), MinLng: math.Min(b.MinLng, o.MinLng),
+		MaxLat: math.Max(b.MaxLat, o.MaxLat), MaxLng: math.Max(b.MaxLng, o.MaxLng),
+	}
+}
+
+// Bounds returns the bounding box of the geometry
+func (m MultiPolygon) Bounds() Box {
+	box := Box{MinLat: math.Inf(1), MinLng: math.Inf(1), MaxLat: math.Inf(-1), MaxLng: math.Inf(-1)}
+	for _, polygon := range m {
+		for _, ring := range polygon {
+			for _, p := range ring {
+				box = box.extend(Box{MinLat: p.Lat, MinLng: p.Lng, MaxLat: p.Lat, MaxLng: p.Lng})
+			}
+		}
+	}
+	return box
+}
+
+// Contains reports whether p lies inside one of the polygons and outside its
+// holes. Coordinates are treated as planar, which is accurate at the scale
+// of administrative units away from the antimeridian.
+func (m MultiPolygon) Contains(p Point) bool {
+	for _, polygon := range m {
+		if len(polygon) == 0 || !polygon[0].contains(p) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if hole.contains(p) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// contains tests p against the ring by ray casting
+func (r Ring) contains(p Point) bool {
+	inside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Points returns the number of points of the geometry
+func (m MultiPolygon) Points() int {
+	n := 0
+	for _, polygon := range m {
+		for _, ring := range polygon {
+			n += len(ring)
+		}
+	}
+	return n
+}
+
+// Feature is a GeoJSON feature with a polygonal geometry
+type Feature struct {
+	Properties map[string]interface{}
+	Geometry   MultiPolygon
+}
+
+// ErrUnsupportedGeometry is returned for features that are not polygons
+var ErrUnsupportedGeometry = errors.New("geometry is not a Polygon or MultiPolygon")
+
+// ParseFeatureCollection parses a GeoJSON FeatureCollection of Polygon and
+// MultiPolygon features. Polygons are returned as single-polygon
+// MultiPolygons. Features that cannot be used are reported by index in
+// skipped rather than failing the whole collection.
+func ParseFeatureCollection(data []byte) (features []Feature, skipped map[int]error, err error) {
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Properties map[string]interface{} `json:"properties"`
+			Geometry   *struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, nil, err
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, nil, fmt.Errorf("expected a FeatureCollection, got %q", collection.Type)
+	}
+
+	skipped = map[int]error{}
+	for i, f := range collection.Features {
+		if f.Geometry == nil {
+			skipped[i] = ErrUnsupportedGeometry
+			continue
+		}
+		var geometry MultiPolygon
+		switch f.Geometry.Type {
+		case "Polygon":
+			var coordinates [][][]float64
+			if err = json.Unmarshal(f.Geometry.Coordinates, &coordinates); err == nil {
+				var polygon Polygon
+				polygon, err = toPolygon(coordinates)
+				geometry = MultiPolygon{polygon}
+			}
+		case "MultiPolygon":
+			var coordinates [][][][]float64
+			if err = json.Unmarshal(f.Geometry.Coordinates, &coordinates); err == nil {
+				for _, c := range coordinates {
+					var polygon Polygon
+					if polygon, err = toPolygon(c); err != nil {
+						break
+					}
+					geometry = append(geometry, polygon)
+				}
+			}
+		default:
+			err = ErrUnsupportedGeometry
+		}
+		if err == nil && len(geometry) == 0 {
+			err = ErrUnsupportedGeometry
+		}
+		if err != nil {
+			skipped[i] = err
+			continue
+		}
+		features = append(features, Feature{Properties: f.Properties, Geometry: geometry})
+	}
+	return features, skipped, nil
+}
+
+// toPolygon converts GeoJSON [lng, lat] rings, closing them when needed
+func toPolygon(coordinates [][][]float64) (Polygon, error) {
+	if len(coordinates) == 0 {
+		return nil, errors.New("polygon has no rings")
+	}
+	polygon := make(Polygon, len(coordinates))
+	for i, positions := range coordinates {
+		ring := make(Ring, 0, len(positions)+1)
+		for _, position := range positions {
+			if len(position) < 2 {
+				return nil, errors.New("position needs a longitude and a latitude")
+			}
+			p := Point{Lat: position[1], Lng: position[0]}
+			if !p.Valid() {
+				return nil, fmt.Errorf("position %v is out of range", position)
+			}
+			ring = append(ring, p)
+		}
+		if len(ring) > 0 && ring[0] != ring[len(ring)-1] {
+			ring = append(ring, ring[0])
+		}
+		if len(ring) < 4 {
+			return nil, errors.New("ring needs at least 3 distinct positions")
+		}
+		polygon[i] = ring
+	}
+	return polygon, nil
+}
+
+// Coordinates returns the geometry as GeoJSON MultiPolygon coordinates
+func (m MultiPolygon) Coordinates() [][][][2]float64 {
+	coordinates := make([][][][2]float64, len(m))
+	for i, polygon := range m {
+		coordinates[i] = make([][][2]float64, len(polygon))
+		for j, ring := range polygon {
+			coordinates[i][j] = make([][2]float64, len(ring))
+			for k, p := range ring {
+				coordinates[i][j][k] = [2]float64{p.Lng, p.Lat}
+			}
+		}
+	}
+	return coordinates
+}
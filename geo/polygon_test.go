@@ -0,0 +1,144 @@
+package geo
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// square returns a closed ring around (lat, lng) with the given half size
+func square(lat, lng, half float64) Ring {
+	return Ring{
+		{Lat: lat - half, Lng: lng - half},
+		{Lat: lat - half, Lng: lng + half},
+		{Lat: lat + half, Lng: lng + half},
+		{Lat: lat + half, Lng: lng - half},
+		{Lat: lat - half, Lng: lng - half},
+	}
+}
+
+func TestMultiPolygonContains(t *testing.T) {
+	m := MultiPolygon{
+		{square(10, 100, 1), square(10, 100, 0.5)},
+		{square(20, 100, 1)},
+	}
+	tests := []struct {
+		p    Point
+		want bool
+	}{
+		{Point{Lat: 10.7, Lng: 100}, true},
+		{Point{Lat: 10, Lng: 100}, false}, // in the hole
+		{Point{Lat: 20, Lng: 100}, true},
+		{Point{Lat: 15, Lng: 100}, false},
+	}
+	for _, tt := range tests {
+		if got := m.Contains(tt.p); got != tt.want {
+			t.Errorf("Contains(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if b := m.Bounds(); b != (Box{MinLat: 9, MinLng: 99, MaxLat: 21, MaxLng: 101}) {
+		t.Errorf("Unexpected bounds %+v", b)
+	}
+}
+
+func TestParseFeatureCollection(t *testing.T) {
+	data := []byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","properties":{"code":"1"},"geometry":{"type":"Polygon","coordinates":[[[100,0],[101,0],[101,1],[100,1]]]}},
+		{"type":"Feature","properties":{"code":"2"},"geometry":{"type":"MultiPolygon","coordinates":[[[[102,0],[103,0],[103,1],[102,0]]]]}},
+		{"type":"Feature","properties":{"code":"3"},"geometry":{"type":"Point","coordinates":[100,0]}},
+		{"type":"Feature","properties":{"code":"4"},"geometry":{"type":"Polygon","coordinates":[[[100,0],[101,0]]]}}
+	]}`)
+	features, skipped, err := ParseFeatureCollection(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(features) != 2 || len(skipped) != 2 || skipped[2] != ErrUnsupportedGeometry || skipped[3] == nil {
+		t.Fatalf("Expected 2 features and 2 skipped, got %d %v", len(features), skipped)
+	}
+	// Open rings are closed
+	if ring := features[0].Geometry[0][0]; len(ring) != 5 || ring[0] != ring[4] {
+		t.Errorf("Expected a closed ring, got %v", ring)
+	}
+	if !features[0].Geometry.Contains(Point{Lat: 0.5, Lng: 100.5}) {
+		t.Error("Expected GeoJSON positions to be read as longitude, latitude")
+	}
+	want := [][][][2]float64{{{{102, 0}, {103, 0}, {103, 1}, {102, 0}}}}
+	if got := features[1].Geometry.Coordinates(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Coordinates() = %v, want %v", got, want)
+	}
+
+	if _, _, err := ParseFeatureCollection([]byte(`{"type":"Feature"}`)); err == nil {
+		t.Error("Expected an error for a single feature")
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	// A square with many points along its edges
+	var ring Ring
+	for i := 0; i < 100; i++ {
+		ring = append(ring, Point{Lat: 0, Lng: float64(i) / 100})
+	}
+	for i := 0; i < 100; i++ {
+		ring = append(ring, Point{Lat: float64(i) / 100, Lng: 1})
+	}
+	ring = append(ring, Point{Lat: 1, Lng: 1}, Point{Lat: 1, Lng: 0}, Point{Lat: 0, Lng: 0})
+	m := MultiPolygon{{ring}}
+
+	if got := m.Simplify(0); got.Points() != m.Points() {
+		t.Errorf("Expected no simplification at zero tolerance, got %d points", got.Points())
+	}
+	simplified := m.Simplify(0.001)
+	if simplified.Points() != 5 {
+		t.Errorf("Expected the 4 corners and the closing point, got %v", simplified[0][0])
+	}
+	if !simplified.Contains(Point{Lat: 0.5, Lng: 0.5}) {
+		t.Error("Expected the simplified square to contain its center")
+	}
+
+	// Rings never collapse below a triangle
+	triangle := MultiPolygon{{Ring{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 0.0001}, {Lat: 0.0001, Lng: 0}, {Lat: 0.00005, Lng: 0}, {Lat: 0, Lng: 0}}}}
+	if got := triangle.Simplify(1); got.Points() < 4 {
+		t.Errorf("Expected a valid ring, got %v", got)
+	}
+}
+
+func TestRTreeSearchMatchesLinearScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	entries := make([]Entry, 1000)
+	for i := range entries {
+		lat, lng := 8+rng.Float64()*15, 102+rng.Float64()*8
+		entries[i] = Entry{ID: strconv.Itoa(i), Box: Box{MinLat: lat, MinLng: lng, MaxLat: lat + rng.Float64()*0.3, MaxLng: lng + rng.Float64()*0.3}}
+	}
+	tree := NewRTree(entries)
+	if tree.Len() != len(entries) {
+		t.Fatalf("Expected %d entries, got %d", len(entries), tree.Len())
+	}
+
+	for q := 0; q < 200; q++ {
+		p := Point{Lat: 8 + rng.Float64()*15, Lng: 102 + rng.Float64()*8}
+		var want []string
+		for _, e := range entries {
+			if e.Box.Contains(p) {
+				want = append(want, e.ID)
+			}
+		}
+		got := tree.Search(p)
+		if len(got) != len(want) {
+			t.Fatalf("Query %v: got %v, want %v", p, got, want)
+		}
+		seen := map[string]bool{}
+		for _, id := range got {
+			seen[id] = true
+		}
+		for _, id := range want {
+			if !seen[id] {
+				t.Fatalf("Query %v: missing %s", p, id)
+			}
+		}
+	}
+
+	if got := NewRTree(nil).Search(Point{}); len(got) != 0 {
+		t.Errorf("Expected no match in an empty tree, got %v", got)
+	}
+}
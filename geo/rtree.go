@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// rtreeNodeSize is the maximum number of entries of an R-tree node
+const rtreeNodeSize = 16
+
+// Entry is a bounding box indexed by an R-tree and the identifier it bounds
+type Entry struct {
+	ID  string
+	Box Box
+}
+
+// RTree indexes bounding boxes for point queries. It is bulk-loaded with
+// the Sort-Tile-Recursive algorithm, which packs nodes full and keeps
+// sibling boxes from overlapping much, then never modified; it is safe for
+// concurrent use.
+type RTree struct {
+	root *rtreeNode
+	size int
+}
+
+type rtreeNode struct {
+	box      Box
+	children []*rtreeNode
+	// entry is set on leaves
+	entry *Entry
+}
+
+// NewRTree builds a tree of the entries
+func NewRTree(entries []Entry) *RTree {
+	nodes := make([]*rtreeNode, len(entries))
+	for i := range entries {
+		nodes[i] = &rtreeNode{box: entries[i].Box, entry: &entries[i]}
+	}
+	if len(nodes) == 0 {
+		return &RTree{}
+	}
+	for len(nodes) > 1 {
+		nodes = packLevel(nodes)
+	}
+	return &RTree{root: nodes[0], size: len(entries)}
+}
+
+// packLevel groups nodes into parents of up to rtreeNodeSize children: the
+// nodes are cut into vertical slices by longitude, and each slice into runs
+// by latitude
+func packLevel(nodes []*rtreeNode) []*rtreeNode {
+	parents := int(math.Ceil(float64(len(nodes)) / rtreeNodeSize))
+	slices := int(math.Ceil(math.Sqrt(float64(parents))))
+	sliceSize := slices * rtreeNodeSize
+
+	sort.Slice(nodes, func(i, j int) bool { return center(nodes[i].box).Lng < center(nodes[j].box).Lng })
+	packed := make([]*rtreeNode, 0, parents)
+	for start := 0; start < len(nodes); start += sliceSize {
+		slice := nodes[start:min(start+sliceSize, len(nodes))]
+		sort.Slice(slice, func(i, j int) bool { return center(slice[i].box).Lat < center(slice[j].box).Lat })
+		for run := 0; run < len(slice); run += rtreeNodeSize {
+			children := slice[run:min(run+rtreeNodeSize, len(slice))]
+			parent := &rtreeNode{box: children[0].box, children: append([]*rtreeNode(nil), children...)}
+			for _, child := range children[1:] {
+				parent.box = parent.box.extend(child.box)
+			}
+			packed = append(packed, parent)
+		}
+	}
+	return packed
+}
+
+func center(b Box) Point {
+	return Point{Lat: (b.MinLat + b.MaxLat) / 2, Lng: (b.MinLng + b.MaxLng) / 2}
+}
+
+// Len returns the number of indexed entries; a nil tree is empty
+func (t *RTree) Len() int {
+	if t == nil {
+		return 0
+	}
+	return t.size
+}
+
+// Search returns the IDs of the entries whose box contains p, sorted
+func (t *RTree) Search(p Point) []string {
+	var ids []string
+	if t.Len() > 0 {
+		t.root.search(p, &ids)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (n *rtreeNode) search(p Point, ids *[]string) {
+	if !n.box.Contains(p) {
+		return
+	}
+	if n.entry != nil {
+		*ids = append(*ids, n.entry.ID)
+		return
+	}
+	for _, child := range n.children {
+		child.search(p, ids)
+	}
+}
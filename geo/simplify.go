@@ -0,0 +1,87 @@
+package geo
+
+import "math"
+
+// Simplify returns the geometry with each ring reduced by the
+// Douglas-Peucker algorithm: points closer than tolerance degrees to the
+// line kept around them are dropped. Rings that would collapse below a
+// triangle are kept whole so the result remains a valid polygon. A
+// tolerance of zero returns the geometry unchanged.
+func (m MultiPolygon) Simplify(tolerance float64) MultiPolygon {
+	if tolerance <= 0 {
+		return m
+	}
+	simplified := make(MultiPolygon, len(m))
+	for i, polygon := range m {
+		simplified[i] = make(Polygon, len(polygon))
+		for j, ring := range polygon {
+			simplified[i][j] = ring.simplify(tolerance)
+		}
+	}
+	return simplified
+}
+
+func (r Ring) simplify(tolerance float64) Ring {
+	if len(r) <= 4 {
+		return r
+	}
+	keep := make([]bool, len(r))
+	keep[0], keep[len(r)-1] = true, true
+	// A closed ring starts and ends on the same point, so it is split at the
+	// point farthest from the start to give the algorithm a baseline
+	far := 0
+	for i := range r {
+		if squaredDistance(r[i], r[0]) > squaredDistance(r[far], r[0]) {
+			far = i
+		}
+	}
+	keep[far] = true
+	douglasPeucker(r, 0, far, tolerance*tolerance, keep)
+	douglasPeucker(r, far, len(r)-1, tolerance*tolerance, keep)
+
+	simplified := make(Ring, 0, len(r))
+	for i, p := range r {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	if len(simplified) < 4 {
+		return r
+	}
+	return simplified
+}
+
+// douglasPeucker marks the points of r[first:last] to keep
+func douglasPeucker(r Ring, first, last int, tolerance2 float64, keep []bool) {
+	if last-first < 2 {
+		return
+	}
+	index, max := 0, -1.0
+	for i := first + 1; i < last; i++ {
+		if d := segmentDistance(r[i], r[first], r[last]); d > max {
+			index, max = i, d
+		}
+	}
+	if max <= tolerance2 {
+		return
+	}
+	keep[index] = true
+	douglasPeucker(r, first, index, tolerance2, keep)
+	douglasPeucker(r, index, last, tolerance2, keep)
+}
+
+// segmentDistance returns the squared planar distance from p to segment ab
+func segmentDistance(p, a, b Point) float64 {
+	dx, dy := b.Lng-a.Lng, b.Lat-a.Lat
+	if dx == 0 && dy == 0 {
+		return squaredDistance(p, a)
+	}
+	t := ((p.Lng-a.Lng)*dx + (p.Lat-a.Lat)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return squaredDistance(p, Point{Lat: a.Lat + t*dy, Lng: a.Lng + t*dx})
+}
+
+func squaredDistance(a, b Point) float64 {
+	dx, dy := a.Lng-b.Lng, a.Lat-b.Lat
+	return dx*dx + dy*dy
+}
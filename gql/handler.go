@@ -0,0 +1,128 @@
+package gql
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Config configures the GraphQL endpoint
+type Config struct {
+	// Schema is the executable schema, built by NewSchema. The endpoint is
+	// only mounted when it is set.
+	Schema *graphql.Schema
+	// Limits bound the depth and complexity of queries
+	Limits Limits
+	// GraphiQL serves the GraphiQL playground to browsers
+	GraphiQL bool
+}
+
+// request is a GraphQL request as sent in a POST body or GET query string
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Handler returns a handler executing GraphQL queries sent as JSON POST
+// bodies or GET query parameters. Browsers requesting the endpoint without a
+// query get the GraphiQL playground when it is enabled.
+func Handler(config Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.GraphiQL && c.Request.Method == http.MethodGet && c.Query("query") == "" &&
+			strings.Contains(c.GetHeader("Accept"), "text/html") {
+			c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(graphiQLPage))
+			return
+		}
+
+		req, err := parseRequest(c)
+		if err != nil {
+			respondWithErrors(c, err)
+			return
+		}
+
+		doc, err := parser.Parse(parser.ParseParams{
+			Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+		})
+		if err != nil {
+			respondWithErrors(c, err)
+			return
+		}
+		if err := checkLimits(*config.Schema, doc, req.Variables, config.Limits); err != nil {
+			respondWithErrors(c, err)
+			return
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         *config.Schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        c.Request.Context(),
+		})
+		c.JSON(http.StatusOK, result)
+	}
+}
+
+func parseRequest(c *gin.Context) (request, error) {
+	var req request
+	switch c.Request.Method {
+	case http.MethodGet:
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return req, gqlerrors.NewFormattedError("variables must be a JSON object")
+			}
+		}
+	default:
+		if err := json.NewDecoder(c.Request.Body).Decode(&req); err != nil {
+			return req, gqlerrors.NewFormattedError("request body must be a JSON object with a query")
+		}
+	}
+
+	if strings.TrimSpace(req.Query) == "" {
+		return req, gqlerrors.NewFormattedError("query is required")
+	}
+	return req, nil
+}
+
+// respondWithErrors rejects a request that could not be executed at all
+func respondWithErrors(c *gin.Context, err error) {
+	c.JSON(http.StatusBadRequest, graphql.Result{
+		Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)},
+	})
+}
+
+// graphiQLPage loads GraphiQL from a CDN and points it at this endpoint
+const graphiQLPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>Vietnam Administrative API - GraphiQL</title>
+  <link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css">
+  <style>body { margin: 0; height: 100vh; } #graphiql { height: 100vh; }</style>
+</head>
+<body>
+  <div id="graphiql">Loading...</div>
+  <script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+  <script>
+    const fetcher = GraphiQL.createFetcher({ url: window.location.pathname });
+    ReactDOM.createRoot(document.getElementById('graphiql')).render(
+      React.createElement(GraphiQL, {
+        fetcher,
+        defaultQuery: '{\n  province(code: "11") {\n    nameWithType\n    wards(limit: 5) {\n      total\n      items { code nameWithType }\n    }\n  }\n}\n',
+      })
+    );
+  </script>
+</body>
+</html>
+`
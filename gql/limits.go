@@ -0,0 +1,160 @@
+package gql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Limits bounds the cost of a query before it is executed
+type Limits struct {
+	// MaxDepth is the deepest allowed nesting of selections
+	MaxDepth int
+	// MaxComplexity bounds the estimated number of resolved fields. Fields
+	// taking a limit argument multiply the cost of their selection by it.
+	MaxComplexity int
+}
+
+// DefaultLimits allow every province with all of its wards, but not nesting
+// wards inside provinces inside wards indefinitely
+func DefaultLimits() Limits {
+	return Limits{MaxDepth: 8, MaxComplexity: 20000}
+}
+
+// analysis walks a document to measure depth and complexity
+type analysis struct {
+	schema    graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	visiting  map[string]bool
+}
+
+// checkLimits returns an error if any operation of the document exceeds the
+// limits. Introspection fields are not counted, so tools like GraphiQL keep
+// working.
+func checkLimits(schema graphql.Schema, doc *ast.Document, variables map[string]interface{}, limits Limits) error {
+	a := &analysis{
+		schema:    schema,
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+		visiting:  map[string]bool{},
+	}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			a.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		operation, ok := def.(*ast.OperationDefinition)
+		if !ok || operation.Operation != ast.OperationTypeQuery {
+			continue
+		}
+		depth, complexity := a.selectionSet(operation.SelectionSet, schema.QueryType())
+		if limits.MaxDepth > 0 && depth > limits.MaxDepth {
+			return fmt.Errorf("query depth %d exceeds the limit of %d", depth, limits.MaxDepth)
+		}
+		if limits.MaxComplexity > 0 && complexity > limits.MaxComplexity {
+			return fmt.Errorf("query complexity %d exceeds the limit of %d", complexity, limits.MaxComplexity)
+		}
+	}
+	return nil
+}
+
+// selectionSet returns the depth and complexity of a selection on parent
+func (a *analysis) selectionSet(set *ast.SelectionSet, parent graphql.Type) (depth, complexity int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	for _, selection := range set.Selections {
+		var d, c int
+		switch s := selection.(type) {
+		case *ast.Field:
+			d, c = a.field(s, parent)
+		case *ast.InlineFragment:
+			d, c = a.selectionSet(s.SelectionSet, a.typeCondition(s.TypeCondition, parent))
+		case *ast.FragmentSpread:
+			fragment := a.fragments[s.Name.Value]
+			if fragment == nil || a.visiting[s.Name.Value] {
+				continue
+			}
+			a.visiting[s.Name.Value] = true
+			d, c = a.selectionSet(fragment.SelectionSet, a.typeCondition(fragment.TypeCondition, parent))
+			delete(a.visiting, s.Name.Value)
+		}
+		if d > depth {
+			depth = d
+		}
+		complexity += c
+	}
+	return depth, complexity
+}
+
+func (a *analysis) field(field *ast.Field, parent graphql.Type) (depth, complexity int) {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return 0, 0
+	}
+
+	var def *graphql.FieldDefinition
+	if object, ok := graphql.GetNamed(parent).(*graphql.Object); ok {
+		def = object.Fields()[field.Name.Value]
+	}
+	if def == nil {
+		// Unknown fields are reported by validation
+		return 1, 1
+	}
+
+	childDepth, childComplexity := a.selectionSet(field.SelectionSet, def.Type)
+	return 1 + childDepth, 1 + a.multiplier(field, def)*childComplexity
+}
+
+// multiplier is the value of the limit argument of a field, or 1
+func (a *analysis) multiplier(field *ast.Field, def *graphql.FieldDefinition) int {
+	for _, arg := range def.Args {
+		if arg.Name() != "limit" {
+			continue
+		}
+		limit, _ := arg.DefaultValue.(int)
+		for _, given := range field.Arguments {
+			if given.Name.Value == "limit" {
+				limit = a.intValue(given.Value, limit)
+			}
+		}
+		if limit < 1 {
+			return 1
+		}
+		return limit
+	}
+	return 1
+}
+
+func (a *analysis) intValue(value ast.Value, fallback int) int {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		if n, err := strconv.Atoi(v.Value); err == nil {
+			return n
+		}
+	case *ast.Variable:
+		switch n := a.variables[v.Name.Value].(type) {
+		case int:
+			return n
+		case float64:
+			return int(n)
+		}
+	}
+	return fallback
+}
+
+func (a *analysis) typeCondition(condition *ast.Named, parent graphql.Type) graphql.Type {
+	if condition == nil {
+		return parent
+	}
+	if typ := a.schema.Type(condition.Name.Value); typ != nil {
+		return typ
+	}
+	return parent
+}
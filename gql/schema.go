@@ -0,0 +1,570 @@
+// Package gql serves the administrative data over GraphQL.
+package gql
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/services"
+)
+
+// maxPageSize and maxOffset cap the limit and offset arguments like the REST
+// endpoints do
+const (
+	maxPageSize = 1000
+	maxOffset   = 10000
+)
+
+// provincePage and wardPage are the sources of the page types
+type provincePage struct {
+	items  []models.Province
+	result services.PageResult
+}
+
+type wardPage struct {
+	items  []models.Ward
+	result services.PageResult
+}
+
+// typeCount is the source of the TypeCount type
+type typeCount struct {
+	typ   string
+	count int
+}
+
+// NewSchema builds the GraphQL schema with resolvers backed by ds
+func NewSchema(ds *services.DataService) (graphql.Schema, error) {
+	// Province and Ward refer to each other, so their fields are thunks
+	// resolved once every type exists
+	var provinceType, wardType *graphql.Object
+
+	coordinatesType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Coordinates",
+		Description: "A WGS 84 latitude and longitude",
+		Fields: graphql.Fields{
+			"lat": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"lng": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+		},
+	})
+	centroidField := func(get func(p graphql.ResolveParams) *models.Coordinates) *graphql.Field {
+		return &graphql.Field{
+			Type:        coordinatesType,
+			Description: "Centroid, when known",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if centroid := get(p); centroid != nil {
+					return centroid, nil
+				}
+				return nil, nil
+			},
+		}
+	}
+	matchedAliasField := func(get func(p graphql.ResolveParams) string) *graphql.Field {
+		return &graphql.Field{
+			Type:        graphql.String,
+			Description: "The alias the unit was designated by, when it was not its name",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if alias := get(p); alias != "" {
+					return alias, nil
+				}
+				return nil, nil
+			},
+		}
+	}
+
+	wardPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "WardPage",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"total":      pageTotalField(),
+				"nextCursor": pageCursorField(),
+				"items": &graphql.Field{
+					Type: listOf(wardType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(wardPage).items, nil
+					},
+				},
+			}
+		}),
+	})
+
+	pageArgs := func(defaultLimit int) graphql.FieldConfigArgument {
+		return graphql.FieldConfigArgument{
+			"search": &graphql.ArgumentConfig{Type: graphql.String, Description: "Match names, slugs and paths"},
+			"type":   &graphql.ArgumentConfig{Type: graphql.String, Description: "Filter by administrative type"},
+			"region": &graphql.ArgumentConfig{Type: graphql.String, Description: "Filter by the region or socio-economic region of the province"},
+			"sort":   &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "name", Description: "name, code, type or province, prefixed with - for descending"},
+			"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultLimit},
+			"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+			"after":  &graphql.ArgumentConfig{Type: graphql.String, Description: "Cursor returned as nextCursor by the previous page"},
+		}
+	}
+
+	wardsField := func(provinceCode func(p graphql.ResolveParams) string) *graphql.Field {
+		args := pageArgs(50)
+		if provinceCode == nil {
+			args["provinceCode"] = &graphql.ArgumentConfig{Type: graphql.String}
+		}
+		return &graphql.Field{
+			Type: graphql.NewNonNull(wardPageType),
+			Args: args,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				page, err := pageFromArgs(p.Args)
+				if err != nil {
+					return nil, err
+				}
+				code, _ := p.Args["provinceCode"].(string)
+				if provinceCode != nil {
+					code = provinceCode(p)
+				}
+				search, _ := p.Args["search"].(string)
+				typeFilter, _ := p.Args["type"].(string)
+				region, err := regionFromArgs(ds, p.Args)
+				if err != nil {
+					return nil, err
+				}
+
+				wards, result, err := ds.SearchWards(p.Context, search, typeFilter, code, region, page)
+				if err != nil {
+					return nil, err
+				}
+				return wardPage{items: wards, result: result}, nil
+			},
+		}
+	}
+
+	provinceType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Province",
+		Description: "A province or centrally governed city",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"code":           provinceField(func(p models.Province) string { return p.Code }),
+				"name":           provinceField(func(p models.Province) string { return p.Name }),
+				"slug":           provinceField(func(p models.Province) string { return p.Slug }),
+				"type":           provinceField(func(p models.Province) string { return p.Type }),
+				"nameWithType":   provinceField(func(p models.Province) string { return p.NameWithType }),
+				"nameEn":         provinceField(func(p models.Province) string { return p.NameEn }),
+				"nameAscii":      provinceField(func(p models.Province) string { return p.NameASCII }),
+				"nameWithTypeEn": provinceField(func(p models.Province) string { return p.NameWithTypeEn }),
+				"region":         provinceField(func(p models.Province) string { return p.Region }),
+				"economicRegion": provinceField(func(p models.Province) string { return p.EconomicRegion }),
+				"phoneCodes": &graphql.Field{
+					Type: listOf(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(models.Province).PhoneCodes, nil
+					},
+				},
+				"plateCodes": &graphql.Field{
+					Type: listOf(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(models.Province).PlateCodes, nil
+					},
+				},
+				"postalCodes": &graphql.Field{
+					Type: listOf(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(models.Province).PostalCodes, nil
+					},
+				},
+				"centroid": centroidField(func(p graphql.ResolveParams) *models.Coordinates {
+					return p.Source.(models.Province).Centroid
+				}),
+				"matchedAlias": matchedAliasField(func(p graphql.ResolveParams) string {
+					return p.Source.(models.Province).MatchedAlias
+				}),
+				"wards": wardsField(func(p graphql.ResolveParams) string {
+					return p.Source.(models.Province).Code
+				}),
+			}
+		}),
+	})
+
+	wardType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Ward",
+		Description: "A ward, commune or town",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"code":           wardField(func(w models.Ward) string { return w.Code }),
+				"name":           wardField(func(w models.Ward) string { return w.Name }),
+				"slug":           wardField(func(w models.Ward) string { return w.Slug }),
+				"type":           wardField(func(w models.Ward) string { return w.Type }),
+				"nameWithType":   wardField(func(w models.Ward) string { return w.NameWithType }),
+				"path":           wardField(func(w models.Ward) string { return w.Path }),
+				"pathWithType":   wardField(func(w models.Ward) string { return w.PathWithType }),
+				"parentCode":     wardField(func(w models.Ward) string { return w.ParentCode }),
+				"nameEn":         wardField(func(w models.Ward) string { return w.NameEn }),
+				"nameAscii":      wardField(func(w models.Ward) string { return w.NameASCII }),
+				"nameWithTypeEn": wardField(func(w models.Ward) string { return w.NameWithTypeEn }),
+				"postalCode": &graphql.Field{
+					Type:        graphql.String,
+					Description: "The ward's own postal code, when known",
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if code := p.Source.(models.Ward).PostalCode; code != "" {
+							return code, nil
+						}
+						return nil, nil
+					},
+				},
+				"centroid": centroidField(func(p graphql.ResolveParams) *models.Coordinates {
+					return p.Source.(models.Ward).Centroid
+				}),
+				"matchedAlias": matchedAliasField(func(p graphql.ResolveParams) string {
+					return p.Source.(models.Ward).MatchedAlias
+				}),
+				"province": &graphql.Field{
+					Type: provinceType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						province, err := ds.GetProvince(p.Context, p.Source.(models.Ward).ParentCode)
+						if err != nil {
+							return nil, nil
+						}
+						return *province, nil
+					},
+				},
+			}
+		}),
+	})
+
+	provincePageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProvincePage",
+		Fields: graphql.Fields{
+			"total":      pageTotalField(),
+			"nextCursor": pageCursorField(),
+			"items": &graphql.Field{
+				Type: listOf(provinceType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(provincePage).items, nil
+				},
+			},
+		},
+	})
+
+	searchResultType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SearchResult",
+		Fields: graphql.Fields{
+			"provinces": &graphql.Field{
+				Type: listOf(provinceType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.SearchData).Provinces, nil
+				},
+			},
+			"wards": &graphql.Field{
+				Type: listOf(wardType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.SearchData).Wards, nil
+				},
+			},
+		},
+	})
+
+	validationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AddressValidation",
+		Fields: graphql.Fields{
+			"valid": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(*models.ValidationResponse).Valid, nil
+				},
+			},
+			"postalCodeValid": &graphql.Field{
+				Type:        graphql.Boolean,
+				Description: "Whether the postal code matches, when one was given",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if postalValid := p.Source.(*models.ValidationResponse).PostalCodeValid; postalValid != nil {
+						return *postalValid, nil
+					}
+					return nil, nil
+				},
+			},
+			"ward": &graphql.Field{
+				Type: wardType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if ward := p.Source.(*models.ValidationResponse).Data; ward != nil {
+						return *ward, nil
+					}
+					return nil, nil
+				},
+			},
+			"province": &graphql.Field{
+				Type: provinceType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if province := p.Source.(*models.ValidationResponse).Province; province != nil {
+						return *province, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	typeCountType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TypeCount",
+		Fields: graphql.Fields{
+			"type": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(typeCount).typ, nil
+				},
+			},
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(typeCount).count, nil
+				},
+			},
+		},
+	})
+
+	statsType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Stats",
+		Fields: graphql.Fields{
+			"provinces":     statsField(graphql.Int, "provinces"),
+			"wards":         statsField(graphql.Int, "wards"),
+			"checksum":      statsField(graphql.String, "checksum"),
+			"loadTime":      statsField(graphql.String, "load_time"),
+			"provinceTypes": statsField(graphql.NewList(graphql.NewNonNull(typeCountType)), "province_types"),
+			"wardTypes":     statsField(graphql.NewList(graphql.NewNonNull(typeCountType)), "ward_types"),
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"province": &graphql.Field{
+				Type: provinceType,
+				Args: graphql.FieldConfigArgument{
+					"code": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					province, err := ds.GetProvince(p.Context, p.Args["code"].(string))
+					if err != nil {
+						return nil, nil
+					}
+					return *province, nil
+				},
+			},
+			"provinces": &graphql.Field{
+				Type: graphql.NewNonNull(provincePageType),
+				Args: pageArgs(50),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					page, err := pageFromArgs(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					search, _ := p.Args["search"].(string)
+					typeFilter, _ := p.Args["type"].(string)
+					region, err := regionFromArgs(ds, p.Args)
+					if err != nil {
+						return nil, err
+					}
+
+					provinces, result, err := ds.SearchProvinces(p.Context, search, typeFilter, region, page)
+					if err != nil {
+						return nil, err
+					}
+					return provincePage{items: provinces, result: result}, nil
+				},
+			},
+			"ward": &graphql.Field{
+				Type: wardType,
+				Args: graphql.FieldConfigArgument{
+					"code": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					ward, err := ds.GetWard(p.Context, p.Args["code"].(string))
+					if err != nil {
+						return nil, nil
+					}
+					return *ward, nil
+				},
+			},
+			"wards": wardsField(nil),
+			"search": &graphql.Field{
+				Type: graphql.NewNonNull(searchResultType),
+				Args: graphql.FieldConfigArgument{
+					"query":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"entity": &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "all", Description: "province, ward or all"},
+					"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					query := p.Args["query"].(string)
+					if len([]rune(query)) < 2 {
+						return nil, errors.New("search query must be at least 2 characters")
+					}
+					limit, err := limitFromArgs(p.Args, 100)
+					if err != nil {
+						return nil, err
+					}
+					return ds.GlobalSearch(p.Context, query, p.Args["entity"].(string), limit), nil
+				},
+			},
+			"validateAddress": &graphql.Field{
+				Type: graphql.NewNonNull(validationType),
+				Args: graphql.FieldConfigArgument{
+					"provinceCode": &graphql.ArgumentConfig{Type: graphql.String},
+					"provinceName": &graphql.ArgumentConfig{Type: graphql.String, Description: "Name or alias of the province, used without provinceCode"},
+					"wardCode":     &graphql.ArgumentConfig{Type: graphql.String},
+					"wardName":     &graphql.ArgumentConfig{Type: graphql.String, Description: "Name or alias of the ward, used without wardCode"},
+					"postalCode":   &graphql.ArgumentConfig{Type: graphql.String, Description: "Also check that the postal code matches the ward"},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address := models.AddressQuery{}
+					address.ProvinceCode, _ = p.Args["provinceCode"].(string)
+					address.ProvinceName, _ = p.Args["provinceName"].(string)
+					address.WardCode, _ = p.Args["wardCode"].(string)
+					address.WardName, _ = p.Args["wardName"].(string)
+					if address.ProvinceCode == "" && address.ProvinceName == "" {
+						return nil, errors.New("provinceCode or provinceName is required")
+					}
+					if address.WardCode == "" && address.WardName == "" {
+						return nil, errors.New("wardCode or wardName is required")
+					}
+					ward, province, valid := ds.ValidateAddress(p.Context, address)
+					response := &models.ValidationResponse{Valid: valid, Data: ward, Province: province}
+					if postalCode, _ := p.Args["postalCode"].(string); postalCode != "" && valid {
+						postalValid := ds.MatchesPostalCode(p.Context, ward.Code, postalCode)
+						response.PostalCodeValid = &postalValid
+						if !postalValid {
+							response.Valid, response.Data, response.Province = false, nil, nil
+						}
+					}
+					return response, nil
+				},
+			},
+			"stats": &graphql.Field{
+				Type: graphql.NewNonNull(statsType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return ds.GetDataStats(), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func provinceField(get func(models.Province) string) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.String),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(models.Province)), nil
+		},
+	}
+}
+
+func wardField(get func(models.Ward) string) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.String),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(models.Ward)), nil
+		},
+	}
+}
+
+func pageTotalField() *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Int),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return pageResult(p.Source).Total, nil
+		},
+	}
+}
+
+func pageCursorField() *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			if cursor := pageResult(p.Source).NextCursor; cursor != "" {
+				return cursor, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func pageResult(source interface{}) services.PageResult {
+	switch page := source.(type) {
+	case provincePage:
+		return page.result
+	case wardPage:
+		return page.result
+	}
+	return services.PageResult{}
+}
+
+// statsField resolves a value of the map returned by GetDataStats
+func statsField(typ graphql.Output, key string) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			value := p.Source.(map[string]interface{})[key]
+			switch v := value.(type) {
+			case time.Time:
+				return v.Format(time.RFC3339), nil
+			case map[string]int:
+				counts := make([]typeCount, 0, len(v))
+				for typ, count := range v {
+					counts = append(counts, typeCount{typ: typ, count: count})
+				}
+				sort.Slice(counts, func(i, j int) bool { return counts[i].typ < counts[j].typ })
+				return counts, nil
+			}
+			return value, nil
+		},
+	}
+}
+
+func listOf(typ graphql.Type) graphql.Output {
+	return graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(typ)))
+}
+
+// regionFromArgs reads the region argument, rejecting regions of no province
+func regionFromArgs(ds *services.DataService, args map[string]interface{}) (string, error) {
+	region, _ := args["region"].(string)
+	if region != "" && !ds.IsRegion(region) {
+		return "", fmt.Errorf("unknown region %q", region)
+	}
+	return region, nil
+}
+
+// pageFromArgs builds a services.Page from the pagination arguments
+func pageFromArgs(args map[string]interface{}) (services.Page, error) {
+	var page services.Page
+
+	limit, err := limitFromArgs(args, maxPageSize)
+	if err != nil {
+		return page, err
+	}
+	page.Limit = limit
+
+	if offset, _ := args["offset"].(int); offset > maxOffset {
+		return page, fmt.Errorf("offset must be at most %d", maxOffset)
+	} else if offset > 0 {
+		page.Offset = offset
+	}
+
+	sortParam, _ := args["sort"].(string)
+	if page.Sort, err = services.ParseSortOrder(sortParam); err != nil {
+		return page, fmt.Errorf("invalid sort %q", sortParam)
+	}
+
+	if after, _ := args["after"].(string); after != "" {
+		if page.After, err = services.DecodeCursor(after); err != nil {
+			return page, err
+		}
+		page.Offset = 0
+	}
+	return page, nil
+}
+
+func limitFromArgs(args map[string]interface{}, max int) (int, error) {
+	limit, _ := args["limit"].(int)
+	if limit <= 0 || limit > max {
+		return 0, fmt.Errorf("limit must be between 1 and %d", max)
+	}
+	return limit, nil
+}
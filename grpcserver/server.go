@@ -0,0 +1,390 @@
+// Package grpcserver serves the administrative data over gRPC, sharing the
+// DataService used by the REST and GraphQL endpoints.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"vietnam-admin-api/models"
+	adminv1 "vietnam-admin-api/proto/vietnamadmin/v1"
+	"vietnam-admin-api/services"
+)
+
+// Page and search limits mirror the REST endpoints
+const (
+	defaultPageSize   = 50
+	maxPageSize       = 1000
+	maxOffset         = 10000
+	defaultSearchSize = 20
+	maxSearchSize     = 100
+)
+
+const invalidSortMessage = "Invalid sort parameter, expected one of name, -name, code, -code, type, -type, province, -province"
+
+// New returns a gRPC server with the admin service, health checking and
+// reflection registered. The health status follows whether data is loaded.
+func New(ds *services.DataService, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	adminv1.RegisterAdminServiceServer(server, &adminServer{ds: ds})
+
+	healthServer := health.NewServer()
+	setHealth := func() {
+		serving := healthpb.HealthCheckResponse_NOT_SERVING
+		if ds.IsDataLoaded() {
+			serving = healthpb.HealthCheckResponse_SERVING
+		}
+		healthServer.SetServingStatus("", serving)
+		healthServer.SetServingStatus(adminv1.AdminService_ServiceDesc.ServiceName, serving)
+	}
+	setHealth()
+	ds.OnReload(setHealth)
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	reflection.Register(server)
+	return server
+}
+
+// adminServer implements AdminService on top of a DataService
+type adminServer struct {
+	adminv1.UnimplementedAdminServiceServer
+	ds *services.DataService
+}
+
+func (s *adminServer) GetProvince(ctx context.Context, req *adminv1.GetProvinceRequest) (*adminv1.Province, error) {
+	if err := s.checkDataLoaded(); err != nil {
+		return nil, err
+	}
+
+	province, err := s.ds.GetProvince(ctx, strings.TrimSpace(req.GetCode()))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Province not found")
+	}
+	return provinceMessage(*province), nil
+}
+
+func (s *adminServer) ListProvinces(ctx context.Context, req *adminv1.ListProvincesRequest) (*adminv1.ListProvincesResponse, error) {
+	if err := s.checkDataLoaded(); err != nil {
+		return nil, err
+	}
+
+	page, err := pageFromRequest(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	region, err := s.region(req.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+	provinces, result, err := s.ds.SearchProvinces(ctx,
+		strings.TrimSpace(req.GetSearch()), strings.TrimSpace(req.GetType()), region, page)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	response := &adminv1.ListProvincesResponse{
+		Provinces: make([]*adminv1.Province, len(provinces)),
+		Page:      pageInfo(page, result),
+	}
+	for i, province := range provinces {
+		response.Provinces[i] = provinceMessage(province)
+	}
+	return response, nil
+}
+
+func (s *adminServer) GetWard(ctx context.Context, req *adminv1.GetWardRequest) (*adminv1.GetWardResponse, error) {
+	if err := s.checkDataLoaded(); err != nil {
+		return nil, err
+	}
+
+	ward, province, err := s.ds.GetWardWithProvince(ctx, strings.TrimSpace(req.GetCode()))
+	if ward == nil {
+		return nil, status.Error(codes.NotFound, "Ward not found")
+	}
+
+	// A ward whose province is missing is still returned, as over REST
+	response := &adminv1.GetWardResponse{Ward: wardMessage(*ward)}
+	if err == nil {
+		response.Province = provinceMessage(*province)
+	}
+	return response, nil
+}
+
+func (s *adminServer) ListWards(ctx context.Context, req *adminv1.ListWardsRequest) (*adminv1.ListWardsResponse, error) {
+	if err := s.checkDataLoaded(); err != nil {
+		return nil, err
+	}
+
+	page, err := pageFromRequest(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	region, err := s.region(req.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+	wards, result, err := s.ds.SearchWards(ctx, strings.TrimSpace(req.GetSearch()),
+		strings.TrimSpace(req.GetType()), strings.TrimSpace(req.GetProvinceCode()), region, page)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	response := &adminv1.ListWardsResponse{
+		Wards: make([]*adminv1.Ward, len(wards)),
+		Page:  pageInfo(page, result),
+	}
+	for i, ward := range wards {
+		response.Wards[i] = wardMessage(ward)
+	}
+	return response, nil
+}
+
+func (s *adminServer) Search(ctx context.Context, req *adminv1.SearchRequest) (*adminv1.SearchResponse, error) {
+	if err := s.checkDataLoaded(); err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.GetQuery())
+	if len(query) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "Search query must be at least 2 characters")
+	}
+
+	entity := strings.TrimSpace(req.GetEntity())
+	switch entity {
+	case "":
+		entity = "all"
+	case "all", "province", "ward":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Invalid entity, expected one of all, province, ward")
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultSearchSize
+	}
+	if limit < 0 || limit > maxSearchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Limit must be between 1 and %d", maxSearchSize)
+	}
+
+	results := s.ds.GlobalSearch(ctx, query, entity, limit)
+	response := &adminv1.SearchResponse{
+		Provinces: make([]*adminv1.Province, len(results.Provinces)),
+		Wards:     make([]*adminv1.Ward, len(results.Wards)),
+	}
+	for i, province := range results.Provinces {
+		response.Provinces[i] = provinceMessage(province)
+	}
+	for i, ward := range results.Wards {
+		response.Wards[i] = wardMessage(ward)
+	}
+	return response, nil
+}
+
+func (s *adminServer) ValidateAddress(ctx context.Context, req *adminv1.ValidateAddressRequest) (*adminv1.ValidateAddressResponse, error) {
+	if err := s.checkDataLoaded(); err != nil {
+		return nil, err
+	}
+
+	postalCode := strings.TrimSpace(req.GetPostalCode())
+	if postalCode != "" && !models.IsPostalCode(postalCode) {
+		return nil, status.Error(codes.InvalidArgument, "Postal code must have 5 digits")
+	}
+
+	if req.GetProvinceCode() == "" && req.GetProvinceName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Province code or name is required")
+	}
+	if req.GetWardCode() == "" && req.GetWardName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Ward code or name is required")
+	}
+
+	ward, province, valid := s.ds.ValidateAddress(ctx, models.AddressQuery{
+		ProvinceCode: req.GetProvinceCode(),
+		ProvinceName: req.GetProvinceName(),
+		WardCode:     req.GetWardCode(),
+		WardName:     req.GetWardName(),
+	})
+	response := &adminv1.ValidateAddressResponse{Valid: valid}
+	if valid && postalCode != "" {
+		response.PostalCodeValid = s.ds.MatchesPostalCode(ctx, ward.Code, postalCode)
+		response.Valid = response.PostalCodeValid
+	}
+	if response.Valid && ward != nil {
+		response.Ward = wardMessage(*ward)
+		response.Province = provinceMessage(*province)
+	}
+	return response, nil
+}
+
+func (s *adminServer) ExportProvinces(req *adminv1.ExportProvincesRequest, stream adminv1.AdminService_ExportProvincesServer) error {
+	if err := s.checkDataLoaded(); err != nil {
+		return err
+	}
+
+	order, err := services.ParseSortOrder(req.GetSort())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, invalidSortMessage)
+	}
+
+	region, err := s.region(req.GetRegion())
+	if err != nil {
+		return err
+	}
+
+	provinces, _ := s.ds.ListProvinces(stream.Context(),
+		strings.TrimSpace(req.GetSearch()), strings.TrimSpace(req.GetType()), region, order)
+	for _, province := range provinces {
+		if err := stream.Send(provinceMessage(province)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *adminServer) ExportWards(req *adminv1.ExportWardsRequest, stream adminv1.AdminService_ExportWardsServer) error {
+	if err := s.checkDataLoaded(); err != nil {
+		return err
+	}
+
+	order, err := services.ParseSortOrder(req.GetSort())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, invalidSortMessage)
+	}
+
+	region, err := s.region(req.GetRegion())
+	if err != nil {
+		return err
+	}
+
+	wards, _ := s.ds.ListWards(stream.Context(), strings.TrimSpace(req.GetSearch()),
+		strings.TrimSpace(req.GetType()), strings.TrimSpace(req.GetProvinceCode()), region, order)
+	for _, ward := range wards {
+		if err := stream.Send(wardMessage(ward)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *adminServer) checkDataLoaded() error {
+	if !s.ds.IsDataLoaded() {
+		return status.Error(codes.Unavailable, "Data not loaded")
+	}
+	return nil
+}
+
+// region validates a region filter against the regions of the loaded provinces
+func (s *adminServer) region(region string) (string, error) {
+	region = strings.TrimSpace(region)
+	if region != "" && !s.ds.IsRegion(region) {
+		return "", status.Errorf(codes.InvalidArgument, "Unknown region %q", region)
+	}
+	return region, nil
+}
+
+// pageFromRequest builds a services.Page, applying the same defaults as the
+// limit and offset query parameters
+func pageFromRequest(req *adminv1.PageRequest) (services.Page, error) {
+	page := services.Page{Limit: int(req.GetLimit()), Offset: int(req.GetOffset())}
+	if page.Limit == 0 {
+		page.Limit = defaultPageSize
+	}
+	if page.Limit < 0 || page.Limit > maxPageSize {
+		return page, status.Errorf(codes.InvalidArgument, "Limit must be between 1 and %d", maxPageSize)
+	}
+	if page.Offset < 0 || page.Offset > maxOffset {
+		return page, status.Errorf(codes.InvalidArgument, "Offset must be between 0 and %d", maxOffset)
+	}
+
+	order, err := services.ParseSortOrder(req.GetSort())
+	if err != nil {
+		return page, status.Error(codes.InvalidArgument, invalidSortMessage)
+	}
+	page.Sort = order
+
+	if raw := strings.TrimSpace(req.GetCursor()); raw != "" {
+		cursor, err := services.DecodeCursor(raw)
+		if err != nil {
+			return page, status.Error(codes.InvalidArgument, "Invalid cursor")
+		}
+		page.After = cursor
+		page.Offset = 0
+	}
+	return page, nil
+}
+
+func pageInfo(page services.Page, result services.PageResult) *adminv1.PageInfo {
+	return &adminv1.PageInfo{
+		Total:      int32(result.Total),
+		Limit:      int32(page.Limit),
+		Offset:     int32(result.Offset),
+		NextCursor: result.NextCursor,
+	}
+}
+
+// serviceError maps pagination errors to status codes like
+// respondWithServiceError does for HTTP
+func serviceError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrStaleCursor):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, services.ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "Internal server error")
+	}
+}
+
+func provinceMessage(p models.Province) *adminv1.Province {
+	message := &adminv1.Province{
+		Code:           p.Code,
+		Name:           p.Name,
+		Slug:           p.Slug,
+		Type:           p.Type,
+		NameWithType:   p.NameWithType,
+		NameEn:         p.NameEn,
+		NameAscii:      p.NameASCII,
+		NameWithTypeEn: p.NameWithTypeEn,
+		Region:         p.Region,
+		EconomicRegion: p.EconomicRegion,
+		PhoneCodes:     p.PhoneCodes,
+		PlateCodes:     p.PlateCodes,
+		PostalCodes:    p.PostalCodes,
+		MatchedAlias:   p.MatchedAlias,
+	}
+	message.Centroid = coordinatesMessage(p.Centroid)
+	return message
+}
+
+func coordinatesMessage(c *models.Coordinates) *adminv1.Coordinates {
+	if c == nil {
+		return nil
+	}
+	return &adminv1.Coordinates{Lat: c.Lat, Lng: c.Lng}
+}
+
+func wardMessage(w models.Ward) *adminv1.Ward {
+	return &adminv1.Ward{
+		Code:           w.Code,
+		Name:           w.Name,
+		Slug:           w.Slug,
+		Type:           w.Type,
+		NameWithType:   w.NameWithType,
+		Path:           w.Path,
+		PathWithType:   w.PathWithType,
+		ParentCode:     w.ParentCode,
+		NameEn:         w.NameEn,
+		NameAscii:      w.NameASCII,
+		NameWithTypeEn: w.NameWithTypeEn,
+		PostalCode:     w.PostalCode,
+		Centroid:       coordinatesMessage(w.Centroid),
+		MatchedAlias:   w.MatchedAlias,
+	}
+}
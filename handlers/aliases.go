@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/services"
+)
+
+// GetAliases handles GET /api/v1/admin/aliases
+func (h *APIHandler) GetAliases(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    h.dataService.GetAliases(),
+	})
+}
+
+// SetAliases handles PUT /api/v1/admin/aliases/:kind/:code
+//
+// The aliases of the unit are replaced and saved to the alias dictionary,
+// and the data is reloaded so that searches use them immediately.
+func (h *APIHandler) SetAliases(c *gin.Context) {
+	var req models.AliasesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondWithError(c, http.StatusBadRequest, models.ErrCodeInvalidBody, middleware.Message(c, "error.invalid_body"), bindingViolations(c, err, req)...)
+		return
+	}
+	h.saveAliases(c, req.Aliases)
+}
+
+// DeleteAliases handles DELETE /api/v1/admin/aliases/:kind/:code
+func (h *APIHandler) DeleteAliases(c *gin.Context) {
+	h.saveAliases(c, nil)
+}
+
+// saveAliases replaces the aliases of the unit named by the path
+func (h *APIHandler) saveAliases(c *gin.Context, aliases []string) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	kind, code := c.Param("kind"), c.Param("code")
+	notFound := "error.province_not_found"
+	switch kind {
+	case services.AliasProvinces:
+	case services.AliasWards:
+		notFound = "error.ward_not_found"
+	default:
+		h.respondInvalidParameters(c, violation(c, "kind", "violation.one_of", "values", "provinces, wards"))
+		return
+	}
+
+	saved, err := h.dataService.SetAliases(c.Request.Context(), kind, code, aliases)
+	switch {
+	case errors.Is(err, services.ErrUnknownUnit):
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, notFound))
+		return
+	case err != nil:
+		logging.FromContext(c.Request.Context()).Error("saving aliases failed", "error", err)
+		h.respondWithError(c, http.StatusInternalServerError, models.ErrCodeInternal, middleware.Message(c, "error.aliases_failed", "error", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Message: middleware.Message(c, "message.aliases_saved"),
+		Data:    models.UnitAliases{Kind: kind, Code: code, Aliases: saved},
+	})
+}
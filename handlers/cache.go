@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/cache"
+	"vietnam-admin-api/metrics"
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+)
+
+// UseCache enables the in-process response cache for search and list
+// endpoints. The cache is purged whenever the data is reloaded.
+func (h *APIHandler) UseCache(responseCache *cache.LRU) {
+	h.responseCache = responseCache
+	h.dataService.OnReload(responseCache.Purge)
+}
+
+// cacheKey builds a cache key from the route template and the normalized
+// query parameters, given as alternating names and values
+func cacheKey(route string, params ...string) string {
+	var b strings.Builder
+	b.WriteString(route)
+	for _, p := range params {
+		b.WriteByte(0)
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// respondCached writes the JSON body stored under key, or builds, caches and
+// writes it. Build errors are reported and not cached. Keys are scoped to the
+// dataset checksum so that a response built from data that was replaced
+// concurrently can never be served afterwards.
+func (h *APIHandler) respondCached(c *gin.Context, key string, build func() (interface{}, error)) {
+	if h.responseCache == nil {
+		response, err := build()
+		if err != nil {
+			h.respondWithServiceError(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	key = h.dataService.GetChecksum() + "|" + key
+	if body, ok := h.responseCache.Get(key); ok {
+		metrics.CacheRequests.Inc("hit")
+		c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+		return
+	}
+	metrics.CacheRequests.Inc("miss")
+
+	response, err := build()
+	if err != nil {
+		h.respondWithServiceError(c, err)
+		return
+	}
+	body, err := json.Marshal(response)
+	if err != nil {
+		h.respondWithError(c, http.StatusInternalServerError, models.ErrCodeInternal, middleware.Message(c, "error.encode_response"))
+		return
+	}
+	h.responseCache.Set(key, body)
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// cacheHealth reports the response cache for the health endpoint
+func (h *APIHandler) cacheHealth() *models.CacheHealth {
+	if h.responseCache == nil {
+		return &models.CacheHealth{Status: "disabled"}
+	}
+
+	stats := h.responseCache.Stats()
+	return &models.CacheHealth{
+		Status:  "healthy",
+		Hits:    stats.Hits,
+		Misses:  stats.Misses,
+		HitRate: stats.HitRate,
+		Entries: stats.Entries,
+		Bytes:   stats.Bytes,
+	}
+}
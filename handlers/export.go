@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/export"
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/services"
+)
+
+// Export handles GET /api/v1/export
+//
+// The table is streamed as it is written, so errors after the first row can
+// only be logged; the response is then truncated.
+func (h *APIHandler) Export(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	format, err := export.ParseFormat(c.Query("format"))
+	if err != nil {
+		h.respondInvalidParameters(c, violation(c, "format", "violation.one_of", "values", "csv, tsv, xlsx"))
+		return
+	}
+	dataset, err := export.ParseDataset(c.Query("dataset"))
+	if err != nil {
+		h.respondInvalidParameters(c, violation(c, "dataset", "violation.one_of", "values", "provinces, wards, wards_with_province"))
+		return
+	}
+	order, err := services.ParseSortOrder(c.Query("sort"))
+	if err != nil {
+		h.respondInvalidParameters(c, violation(c, "sort", "violation.invalid_sort"))
+		return
+	}
+	bom, err := strconv.ParseBool(c.DefaultQuery("bom", "false"))
+	if err != nil {
+		h.respondInvalidParameters(c, violation(c, "bom", "violation.boolean"))
+		return
+	}
+	region, ok := h.parseRegion(c)
+	if !ok {
+		return
+	}
+
+	query := export.Query{
+		Dataset:      dataset,
+		Search:       strings.TrimSpace(c.Query("search")),
+		Type:         strings.TrimSpace(c.Query("type")),
+		ProvinceCode: strings.TrimSpace(c.Query("province_code")),
+		Region:       region,
+		Sort:         order,
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", `attachment; filename="`+string(dataset)+"."+string(format)+`"`)
+	c.Status(http.StatusOK)
+
+	ctx := c.Request.Context()
+	rows := 0
+	rw, err := export.NewRowWriter(c.Writer, format, export.Options{BOM: bom, SheetName: string(dataset)})
+	if err == nil {
+		rows, err = export.Write(ctx, h.dataService, rw, query)
+		if closeErr := rw.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		logging.FromContext(ctx).Warn("export interrupted", "error", err, "rows", rows)
+	}
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/services"
+)
+
+// maxNearestWards bounds the limit of /geo/nearest
+const maxNearestWards = 50
+
+// NearestWards handles GET /api/v1/geo/nearest
+//
+// Wards are ranked by the great-circle distance from the point to their
+// centroid, using the k-d tree built when the data is loaded.
+func (h *APIHandler) NearestWards(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	var violations []models.FieldViolation
+	lat, violations := coordinateParam(c, "lat", 90, violations)
+	lng, violations := coordinateParam(c, "lng", 180, violations)
+	limit, violations := intParam(c, "limit", 5, 1, maxNearestWards, violations)
+	if len(violations) > 0 {
+		h.respondInvalidParameters(c, violations...)
+		return
+	}
+
+	results, err := h.dataService.NearestWards(c.Request.Context(), lat, lng, limit)
+	if errors.Is(err, services.ErrCentroidsUnavailable) {
+		h.respondWithError(c, http.StatusServiceUnavailable, models.ErrCodeDataUnavailable, middleware.Message(c, "error.centroids_unavailable"))
+		return
+	}
+	lang := strings.ToLower(strings.TrimSpace(c.Query(middleware.LanguageParam)))
+	for i := range results {
+		results[i].Ward = results[i].Ward.Localized(lang)
+		if results[i].Province != nil {
+			province := results[i].Province.Localized(lang)
+			results[i].Province = &province
+		}
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    results,
+	})
+}
+
+// ReverseGeocode handles GET /api/v1/geo/reverse
+//
+// The R-tree of boundary bounding boxes narrows the candidates, which are
+// then tested for containment of the point.
+func (h *APIHandler) ReverseGeocode(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	var violations []models.FieldViolation
+	lat, violations := coordinateParam(c, "lat", 90, violations)
+	lng, violations := coordinateParam(c, "lng", 180, violations)
+	if len(violations) > 0 {
+		h.respondInvalidParameters(c, violations...)
+		return
+	}
+
+	result, err := h.dataService.ReverseGeocode(c.Request.Context(), lat, lng)
+	switch {
+	case errors.Is(err, services.ErrBoundariesUnavailable):
+		h.respondWithError(c, http.StatusServiceUnavailable, models.ErrCodeDataUnavailable, middleware.Message(c, "error.boundaries_unavailable"))
+		return
+	case err != nil:
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.location_not_found"))
+		return
+	}
+
+	lang := strings.ToLower(strings.TrimSpace(c.Query(middleware.LanguageParam)))
+	result.Ward = result.Ward.Localized(lang)
+	if result.Province != nil {
+		province := result.Province.Localized(lang)
+		result.Province = &province
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// GetWardBoundary handles GET /api/v1/wards/:code/boundary
+//
+// The boundary is written as a GeoJSON Feature, simplified according to
+// ?simplify=.
+func (h *APIHandler) GetWardBoundary(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	level := strings.ToLower(strings.TrimSpace(c.DefaultQuery("simplify", services.SimplifyLevels[0])))
+	feature, err := h.dataService.GetWardBoundary(c.Request.Context(), c.Param("code"), level)
+	switch {
+	case errors.Is(err, services.ErrUnknownSimplifyLevel):
+		h.respondInvalidParameters(c, violation(c, "simplify", "violation.one_of",
+			"values", strings.Join(services.SimplifyLevels, ", ")))
+		return
+	case errors.Is(err, services.ErrUnknownUnit):
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.ward_not_found"))
+		return
+	case errors.Is(err, services.ErrNoBoundary):
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.boundary_not_found"))
+		return
+	case err != nil:
+		h.respondWithError(c, http.StatusInternalServerError, models.ErrCodeInternal, middleware.Message(c, "error.internal"))
+		return
+	}
+
+	c.Header("Content-Type", "application/geo+json")
+	c.JSON(http.StatusOK, feature)
+}
+
+// coordinateParam parses a required latitude or longitude within
+// [-max, max], appending a violation when it is missing or invalid
+func coordinateParam(c *gin.Context, name string, max float64, violations []models.FieldViolation) (float64, []models.FieldViolation) {
+	raw := strings.TrimSpace(c.Query(name))
+	if raw == "" {
+		return 0, append(violations, violation(c, name, "violation.required"))
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(value) || value < -max || value > max {
+		return 0, append(violations, violation(c, name, "violation.number_range", "min", -max, "max", max))
+	}
+	return value, violations
+}
@@ -1,23 +1,33 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
 	"math"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
+	"vietnam-admin-api/cache"
+	"vietnam-admin-api/ime"
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/metrics"
+	"vietnam-admin-api/middleware"
 	"vietnam-admin-api/models"
 	"vietnam-admin-api/services"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
 )
 
 // APIHandler contains the data service and handles HTTP requests
 type APIHandler struct {
-	dataService *services.DataService
-	startTime   time.Time
-	version     string
+	dataService   *services.DataService
+	responseCache *cache.LRU
+	startTime     time.Time
+	version       string
 }
 
 // NewAPIHandler creates a new APIHandler
@@ -29,39 +39,200 @@ func NewAPIHandler(dataService *services.DataService, version string) *APIHandle
 	}
 }
 
+// Upper bounds of the limit and offset parameters. Offsets are capped since
+// skipping still walks the sorted results; cursors page without a bound.
+const (
+	maxPageSize    = 1000
+	maxSearchLimit = 100
+	maxOffset      = 10000
+)
+
 // Helper functions
 
-func (h *APIHandler) parseQueryParams(c *gin.Context) (search, typeFilter string, limit, offset int) {
+// parseQueryParams reads the parameters shared by list endpoints. Limits and
+// offsets that are malformed or out of range are rejected with 400 rather
+// than replaced with defaults.
+func (h *APIHandler) parseQueryParams(c *gin.Context) (search, typeFilter string, limit, offset int, ok bool) {
 	search = strings.TrimSpace(c.Query("search"))
 	typeFilter = strings.TrimSpace(c.Query("type"))
 
-	limit = 50 // default
-	if l := c.Query("limit"); l != "" {
-		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 1000 {
-			limit = parsed
-		}
+	var violations []models.FieldViolation
+	search, violations = imeParam(c, search, violations)
+	limit, violations = intParam(c, "limit", 50, 1, maxPageSize, violations)
+	offset, violations = intParam(c, "offset", 0, 0, maxOffset, violations)
+	if len(violations) > 0 {
+		h.respondInvalidParameters(c, violations...)
+		return search, typeFilter, limit, offset, false
+	}
+	return search, typeFilter, limit, offset, true
+}
+
+// intParam parses an optional integer query parameter within [min, max],
+// appending a violation when it is invalid
+func intParam(c *gin.Context, name string, def, min, max int, violations []models.FieldViolation) (int, []models.FieldViolation) {
+	raw := strings.TrimSpace(c.Query(name))
+	if raw == "" {
+		return def, violations
 	}
 
-	offset = 0 // default
-	if o := c.Query("offset"); o != "" {
-		if parsed, err := strconv.Atoi(o); err == nil && parsed >= 0 {
-			offset = parsed
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < min || value > max {
+		return def, append(violations, violation(c, name, "violation.int_range", "min", min, "max", max))
+	}
+	return value, violations
+}
+
+// imeParam converts a search typed as raw keystrokes of the input method
+// selected with ?ime=, appending a violation for unknown methods. Without
+// the parameter the search is returned unchanged.
+func imeParam(c *gin.Context, search string, violations []models.FieldViolation) (string, []models.FieldViolation) {
+	raw := c.Query("ime")
+	if raw == "" {
+		return search, violations
+	}
+	method, err := ime.ParseMethod(raw)
+	if err != nil {
+		return search, append(violations, violation(c, "ime", "violation.one_of", "values", "telex, vni, auto"))
+	}
+	return ime.Convert(search, method), violations
+}
+
+// parseRegion reads the optional region filter, rejecting regions and
+// socio-economic regions that no province belongs to
+func (h *APIHandler) parseRegion(c *gin.Context) (string, bool) {
+	region := strings.TrimSpace(c.Query("region"))
+	if region != "" && !h.dataService.IsRegion(region) {
+		h.respondInvalidParameters(c, violation(c, "region", "violation.one_of",
+			"values", strings.Join(h.dataService.GetRegions(), ", ")))
+		return region, false
+	}
+	return region, true
+}
+
+// violation returns a localized violation of a parameter or body field. The
+// field name is available to the message as {field}.
+func violation(c *gin.Context, field, key string, args ...interface{}) models.FieldViolation {
+	args = append([]interface{}{"field", field}, args...)
+	return models.FieldViolation{Field: field, Message: middleware.Message(c, key, args...)}
+}
+
+// parsePage builds the page selection from limit/offset, the sort order and
+// the optional opaque cursor, which takes precedence over offset
+func (h *APIHandler) parsePage(c *gin.Context, limit, offset int) (services.Page, bool) {
+	page := services.Page{Limit: limit, Offset: offset}
+
+	order, err := services.ParseSortOrder(c.Query("sort"))
+	if err != nil {
+		h.respondInvalidParameters(c, violation(c, "sort", "violation.invalid_sort"))
+		return page, false
+	}
+	page.Sort = order
+
+	if raw := strings.TrimSpace(c.Query("cursor")); raw != "" {
+		cursor, err := services.DecodeCursor(raw)
+		if err != nil {
+			h.respondInvalidParameters(c, violation(c, "cursor", "violation.invalid_cursor"))
+			return page, false
 		}
+		page.After = cursor
+		page.Offset = 0
 	}
 
-	return
+	return page, true
 }
 
-func (h *APIHandler) respondWithError(c *gin.Context, status int, message string) {
-	c.JSON(status, models.APIResponse{
-		Success: false,
-		Message: message,
-	})
+// paginatedResponse wraps a page of results with its pagination metadata
+func paginatedResponse(data interface{}, limit int, result services.PageResult) models.PaginatedResponse {
+	return models.PaginatedResponse{
+		Success: true,
+		Data:    data,
+		Pagination: models.Pagination{
+			Total:      result.Total,
+			Limit:      limit,
+			Offset:     result.Offset,
+			Pages:      int(math.Ceil(float64(result.Total) / float64(limit))),
+			NextCursor: result.NextCursor,
+		},
+	}
+}
+
+// respondWithServiceError maps errors returned by the data service to responses
+func (h *APIHandler) respondWithServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrStaleCursor):
+		h.respondWithError(c, http.StatusConflict, models.ErrCodeStaleCursor,
+			middleware.Message(c, "error.stale_cursor"), violation(c, "cursor", "violation.stale_cursor"))
+	case errors.Is(err, services.ErrInvalidCursor):
+		h.respondInvalidParameters(c, violation(c, "cursor", "violation.invalid_cursor"))
+	default:
+		h.respondWithError(c, http.StatusInternalServerError, models.ErrCodeInternal, middleware.Message(c, "error.internal"))
+	}
+}
+
+// respondWithError writes the error envelope shared by every endpoint
+func (h *APIHandler) respondWithError(c *gin.Context, status int, code models.ErrorCode, message string, details ...models.FieldViolation) {
+	c.JSON(status, models.NewErrorResponse(code, message, middleware.GetRequestID(c), details...))
+}
+
+// respondInvalidParameters rejects a request with 400, listing each invalid
+// parameter. A single violation also provides the message.
+func (h *APIHandler) respondInvalidParameters(c *gin.Context, violations ...models.FieldViolation) {
+	message := middleware.Message(c, "error.invalid_parameters")
+	if len(violations) == 1 {
+		message = violations[0].Message
+	}
+	h.respondWithError(c, http.StatusBadRequest, models.ErrCodeInvalidParameter, message, violations...)
+}
+
+// bindingViolations lists the body fields rejected when binding into obj,
+// named by their json tags. Bodies that are not valid JSON have none.
+func bindingViolations(c *gin.Context, err error, obj interface{}) []models.FieldViolation {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return []models.FieldViolation{violation(c, typeErr.Field, "violation.type", "type", typeErr.Type.String())}
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+	t := reflect.TypeOf(obj)
+	violations := make([]models.FieldViolation, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		name := fieldErr.Field()
+		if field, ok := t.FieldByName(fieldErr.StructField()); ok {
+			if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+				name = tag
+			}
+		}
+		switch fieldErr.Tag() {
+		case "required":
+			violations = append(violations, violation(c, name, "violation.required"))
+		case "required_without":
+			other := fieldErr.Param()
+			if field, ok := t.FieldByName(other); ok {
+				if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+					other = tag
+				}
+			}
+			violations = append(violations, violation(c, name, "violation.required_without", "other", other))
+		default:
+			violations = append(violations, violation(c, name, "violation.invalid"))
+		}
+	}
+	return violations
+}
+
+// observeSearch records the number of matches of a text search
+func (h *APIHandler) observeSearch(entity, search string, matches int) {
+	if search != "" {
+		metrics.SearchResults.Observe(float64(matches), entity)
+	}
 }
 
 func (h *APIHandler) checkDataLoaded(c *gin.Context) bool {
 	if !h.dataService.IsDataLoaded() {
-		h.respondWithError(c, http.StatusServiceUnavailable, "Data not loaded")
+		h.respondWithError(c, http.StatusServiceUnavailable, models.ErrCodeDataUnavailable, middleware.Message(c, "error.data_unavailable"))
 		return false
 	}
 	return true
@@ -75,19 +246,33 @@ func (h *APIHandler) GetProvinces(c *gin.Context) {
 		return
 	}
 
-	search, typeFilter, limit, offset := h.parseQueryParams(c)
+	search, typeFilter, limit, offset, ok := h.parseQueryParams(c)
+	if !ok {
+		return
+	}
+	region, ok := h.parseRegion(c)
+	if !ok {
+		return
+	}
+	page, ok := h.parsePage(c, limit, offset)
+	if !ok {
+		return
+	}
+	projection, ok := h.parseProjection(c, nil, models.ProvinceFieldNames)
+	if !ok {
+		return
+	}
 
-	provinces, total := h.dataService.SearchProvinces(search, typeFilter, limit, offset)
+	key := cacheKey(c.FullPath(), strings.ToLower(search), typeFilter, region,
+		strconv.Itoa(limit), strconv.Itoa(page.Offset), page.Sort.String(), c.Query("cursor"), projection.key())
+	h.respondCached(c, key, func() (interface{}, error) {
+		provinces, result, err := h.dataService.SearchProvinces(c.Request.Context(), search, typeFilter, region, page)
+		if err != nil {
+			return nil, err
+		}
+		h.observeSearch("province", search, result.Total)
 
-	c.JSON(http.StatusOK, models.PaginatedResponse{
-		Success: true,
-		Data:    provinces,
-		Pagination: models.Pagination{
-			Total:  total,
-			Limit:  limit,
-			Offset: offset,
-			Pages:  int(math.Ceil(float64(total) / float64(limit))),
-		},
+		return paginatedResponse(projectProvinces(projection, provinces), limit, result), nil
 	})
 }
 
@@ -99,19 +284,24 @@ func (h *APIHandler) GetProvince(c *gin.Context) {
 
 	code := c.Param("code")
 	if code == "" {
-		h.respondWithError(c, http.StatusBadRequest, "Province code is required")
+		h.respondInvalidParameters(c, violation(c, "code", "violation.required"))
+		return
+	}
+
+	projection, ok := h.parseProjection(c, nil, models.ProvinceFieldNames)
+	if !ok {
 		return
 	}
 
-	province, err := h.dataService.GetProvince(code)
+	province, err := h.dataService.GetProvince(c.Request.Context(), code)
 	if err != nil {
-		h.respondWithError(c, http.StatusNotFound, "Province not found")
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.province_not_found"))
 		return
 	}
 
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
-		Data:    province,
+		Data:    projection.province(*province),
 	})
 }
 
@@ -123,31 +313,23 @@ func (h *APIHandler) GetProvinceWards(c *gin.Context) {
 
 	provinceCode := c.Param("code")
 	if provinceCode == "" {
-		h.respondWithError(c, http.StatusBadRequest, "Province code is required")
+		h.respondInvalidParameters(c, violation(c, "code", "violation.required"))
 		return
 	}
 
 	// Check if province exists
-	_, err := h.dataService.GetProvince(provinceCode)
+	_, err := h.dataService.GetProvince(c.Request.Context(), provinceCode)
 	if err != nil {
-		h.respondWithError(c, http.StatusNotFound, "Province not found")
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.province_not_found"))
 		return
 	}
 
-	search, typeFilter, limit, offset := h.parseQueryParams(c)
-
-	wards, total := h.dataService.SearchWards(search, typeFilter, provinceCode, limit, offset)
+	search, typeFilter, limit, offset, ok := h.parseQueryParams(c)
+	if !ok {
+		return
+	}
 
-	c.JSON(http.StatusOK, models.PaginatedResponse{
-		Success: true,
-		Data:    wards,
-		Pagination: models.Pagination{
-			Total:  total,
-			Limit:  limit,
-			Offset: offset,
-			Pages:  int(math.Ceil(float64(total) / float64(limit))),
-		},
-	})
+	h.respondWards(c, search, typeFilter, provinceCode, limit, offset)
 }
 
 // Ward Handlers
@@ -158,20 +340,41 @@ func (h *APIHandler) GetWards(c *gin.Context) {
 		return
 	}
 
-	search, typeFilter, limit, offset := h.parseQueryParams(c)
+	search, typeFilter, limit, offset, ok := h.parseQueryParams(c)
+	if !ok {
+		return
+	}
 	provinceCode := strings.TrimSpace(c.Query("province_code"))
 
-	wards, total := h.dataService.SearchWards(search, typeFilter, provinceCode, limit, offset)
+	h.respondWards(c, search, typeFilter, provinceCode, limit, offset)
+}
 
-	c.JSON(http.StatusOK, models.PaginatedResponse{
-		Success: true,
-		Data:    wards,
-		Pagination: models.Pagination{
-			Total:  total,
-			Limit:  limit,
-			Offset: offset,
-			Pages:  int(math.Ceil(float64(total) / float64(limit))),
-		},
+// respondWards writes a paginated, cached ward search result
+func (h *APIHandler) respondWards(c *gin.Context, search, typeFilter, provinceCode string, limit, offset int) {
+	region, ok := h.parseRegion(c)
+	if !ok {
+		return
+	}
+	page, ok := h.parsePage(c, limit, offset)
+	if !ok {
+		return
+	}
+	projection, ok := h.parseProjection(c, []string{includeProvince}, models.WardFieldNames)
+	if !ok {
+		return
+	}
+
+	key := cacheKey(c.FullPath(), strings.ToLower(search), typeFilter, provinceCode, region,
+		strconv.Itoa(limit), strconv.Itoa(page.Offset), page.Sort.String(), c.Query("cursor"), projection.key())
+	h.respondCached(c, key, func() (interface{}, error) {
+		ctx := c.Request.Context()
+		wards, result, err := h.dataService.SearchWards(ctx, search, typeFilter, provinceCode, region, page)
+		if err != nil {
+			return nil, err
+		}
+		h.observeSearch("ward", search, result.Total)
+
+		return paginatedResponse(h.projectWards(ctx, projection, wards), limit, result), nil
 	})
 }
 
@@ -183,40 +386,30 @@ func (h *APIHandler) GetWard(c *gin.Context) {
 
 	code := c.Param("code")
 	if code == "" {
-		h.respondWithError(c, http.StatusBadRequest, "Ward code is required")
+		h.respondInvalidParameters(c, violation(c, "code", "violation.required"))
 		return
 	}
 
-	ward, province, err := h.dataService.GetWardWithProvince(code)
-	if err != nil {
-		h.respondWithError(c, http.StatusNotFound, "Ward not found")
+	projection, ok := h.parseProjection(c, []string{includeProvince}, models.WardFieldNames)
+	if !ok {
 		return
 	}
 
-	// Create enhanced ward response with province info
-	response := map[string]interface{}{
-		"code":           ward.Code,
-		"name":           ward.Name,
-		"slug":           ward.Slug,
-		"type":           ward.Type,
-		"name_with_type": ward.NameWithType,
-		"path":           ward.Path,
-		"path_with_type": ward.PathWithType,
-		"parent_code":    ward.ParentCode,
+	ward, province, err := h.dataService.GetWardWithProvince(c.Request.Context(), code)
+	if err != nil {
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.ward_not_found"))
+		return
 	}
 
+	// The ward detail always embeds its province, include=province or not
+	view := projection.ward(*ward)
 	if province != nil {
-		response["province"] = map[string]interface{}{
-			"code":           province.Code,
-			"name":           province.Name,
-			"name_with_type": province.NameWithType,
-			"type":           province.Type,
-		}
+		localized := province.Localized(projection.lang)
+		view.Province = &localized
 	}
-
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
-		Data:    response,
+		Data:    view,
 	})
 }
 
@@ -228,30 +421,59 @@ func (h *APIHandler) GlobalSearch(c *gin.Context) {
 		return
 	}
 
+	var violations []models.FieldViolation
 	query := strings.TrimSpace(c.Query("q"))
 	if len(query) < 2 {
-		h.respondWithError(c, http.StatusBadRequest, "Search query must be at least 2 characters")
-		return
+		violations = append(violations, violation(c, "q", "violation.min_length", "min", 2))
 	}
 
 	entity := strings.TrimSpace(c.Query("entity"))
-	if entity == "" {
+	switch entity {
+	case "":
 		entity = "all"
+	case "all", "province", "ward":
+	default:
+		violations = append(violations, violation(c, "entity", "violation.one_of", "values", "all, province, ward"))
 	}
 
-	limit := 20
-	if l := c.Query("limit"); l != "" {
-		if parsed, err := strconv.Atoi(l); err == nil && parsed > 0 && parsed <= 100 {
-			limit = parsed
-		}
+	limit, violations := intParam(c, "limit", 20, 1, maxSearchLimit, violations)
+	search, violations := imeParam(c, query, violations)
+	if len(violations) > 0 {
+		h.respondInvalidParameters(c, violations...)
+		return
 	}
 
-	results := h.dataService.GlobalSearch(query, entity, limit)
+	projection, ok := h.parseProjection(c, []string{includeProvince}, models.ProvinceFieldNames, models.WardFieldNames)
+	if !ok {
+		return
+	}
 
-	c.JSON(http.StatusOK, models.SearchResponse{
-		Success: true,
-		Data:    results,
-		Query:   query,
+	// The query is echoed in the response, so it is only trimmed, not lowercased
+	key := cacheKey(c.FullPath(), query, search, entity, strconv.Itoa(limit), projection.key())
+	h.respondCached(c, key, func() (interface{}, error) {
+		ctx := c.Request.Context()
+		results := h.dataService.GlobalSearch(ctx, search, entity, limit)
+		if entity == "all" || entity == "province" {
+			h.observeSearch("province", search, len(results.Provinces))
+		}
+		if entity == "all" || entity == "ward" {
+			h.observeSearch("ward", search, len(results.Wards))
+		}
+
+		var converted string
+		if search != query {
+			converted = search
+		}
+
+		return models.SearchResponse{
+			Success: true,
+			Data: models.SearchView{
+				Provinces: projectProvinces(projection, results.Provinces),
+				Wards:     h.projectWards(ctx, projection, results.Wards),
+			},
+			Query:          query,
+			ConvertedQuery: converted,
+		}, nil
 	})
 }
 
@@ -265,22 +487,41 @@ func (h *APIHandler) ValidateAddress(c *gin.Context) {
 
 	var req models.ValidationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		h.respondWithError(c, http.StatusBadRequest, "Invalid request body")
+		h.respondWithError(c, http.StatusBadRequest, models.ErrCodeInvalidBody, middleware.Message(c, "error.invalid_body"), bindingViolations(c, err, req)...)
+		return
+	}
+
+	postalCode := strings.TrimSpace(req.PostalCode)
+	if postalCode != "" && !models.IsPostalCode(postalCode) {
+		h.respondWithError(c, http.StatusBadRequest, models.ErrCodeInvalidBody, middleware.Message(c, "error.invalid_body"),
+			violation(c, "postal_code", "violation.postal_code"))
 		return
 	}
 
-	ward, valid := h.dataService.ValidateAddress(req.ProvinceCode, req.WardCode)
+	ctx := c.Request.Context()
+	ward, province, valid := h.dataService.ValidateAddress(ctx, req.Address())
 
 	response := models.ValidationResponse{
 		Success: true,
 		Valid:   valid,
 	}
 
-	if valid && ward != nil {
-		response.Data = ward
-		response.Message = "Address is valid"
-	} else {
-		response.Message = "Invalid address combination"
+	switch {
+	case !valid || ward == nil:
+		response.Message = middleware.Message(c, "message.address_invalid")
+	case postalCode != "" && !h.dataService.MatchesPostalCode(ctx, ward.Code, postalCode):
+		response.Valid = false
+		response.PostalCodeValid = new(bool)
+		response.Message = middleware.Message(c, "message.postal_code_mismatch")
+	default:
+		lang := strings.ToLower(strings.TrimSpace(c.Query(middleware.LanguageParam)))
+		localized, localizedProvince := ward.Localized(lang), province.Localized(lang)
+		response.Data, response.Province = &localized, &localizedProvince
+		if postalCode != "" {
+			postalValid := true
+			response.PostalCodeValid = &postalValid
+		}
+		response.Message = middleware.Message(c, "message.address_valid")
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -293,6 +534,7 @@ func (h *APIHandler) Health(c *gin.Context) {
 	status := "healthy"
 	services := models.Services{
 		DataLoader: "healthy",
+		Cache:      h.cacheHealth(),
 	}
 
 	if !h.dataService.IsDataLoaded() {
@@ -326,6 +568,9 @@ func (h *APIHandler) Stats(c *gin.Context) {
 	stats := h.dataService.GetDataStats()
 	stats["uptime"] = time.Since(h.startTime).String()
 	stats["version"] = h.version
+	if h.responseCache != nil {
+		stats["cache"] = h.responseCache.Stats()
+	}
 
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
@@ -335,15 +580,16 @@ func (h *APIHandler) Stats(c *gin.Context) {
 
 // ReloadData handles POST /api/v1/admin/reload (Admin endpoint)
 func (h *APIHandler) ReloadData(c *gin.Context) {
-	err := h.dataService.ReloadData()
+	err := h.dataService.ReloadData(c.Request.Context())
 	if err != nil {
-		h.respondWithError(c, http.StatusInternalServerError, "Failed to reload data: "+err.Error())
+		logging.FromContext(c.Request.Context()).Error("data reload failed", "error", err)
+		h.respondWithError(c, http.StatusInternalServerError, models.ErrCodeInternal, middleware.Message(c, "error.reload_failed", "error", err))
 		return
 	}
 
 	c.JSON(http.StatusOK, models.APIResponse{
 		Success: true,
-		Message: "Data reloaded successfully",
+		Message: middleware.Message(c, "message.data_reloaded"),
 		Data: map[string]interface{}{
 			"reload_time": time.Now(),
 			"stats":       h.dataService.GetDataStats(),
@@ -365,6 +611,20 @@ func (h *APIHandler) GetProvinceTypes(c *gin.Context) {
 	})
 }
 
+// GetRegions handles GET /api/v1/provinces/regions
+func (h *APIHandler) GetRegions(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	regions := h.dataService.GetRegions()
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    regions,
+	})
+}
+
 // GetWardTypes handles GET /api/v1/wards/types
 func (h *APIHandler) GetWardTypes(c *gin.Context) {
 	if !h.checkDataLoaded(c) {
@@ -381,13 +641,5 @@ func (h *APIHandler) GetWardTypes(c *gin.Context) {
 
 // NotFound handles 404 errors
 func (h *APIHandler) NotFound(c *gin.Context) {
-	h.respondWithError(c, http.StatusNotFound, "Endpoint not found")
-}
-
-// Options handles CORS preflight requests
-func (h *APIHandler) Options(c *gin.Context) {
-	c.Header("Access-Control-Allow-Origin", "*")
-	c.Header("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-	c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
-	c.Status(http.StatusOK)
+	h.respondWithError(c, http.StatusNotFound, models.ErrCodeRouteNotFound, middleware.Message(c, "error.route_not_found"))
 }
@@ -0,0 +1,467 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/openapi"
+)
+
+// OpenAPIOptions describes the optional routes mounted by the router
+type OpenAPIOptions struct {
+	Version      string
+	GraphQL      bool
+	Languages    []string
+	AdminAliases bool
+}
+
+// OpenAPI returns a handler serving doc as JSON. The document is encoded
+// once, as it does not change while the server runs.
+func (h *APIHandler) OpenAPI(doc *openapi.Document) gin.HandlerFunc {
+	body, err := json.Marshal(doc)
+	return func(c *gin.Context) {
+		if err != nil {
+			h.respondWithError(c, http.StatusInternalServerError, models.ErrCodeInternal, middleware.Message(c, "error.encode_response"))
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+	}
+}
+
+// NewOpenAPIDocument describes every route mounted by the router. Schemas are
+// derived from the models, so only the routes and their parameters are listed
+// here; keep them in step with setupRouter and the handlers.
+func NewOpenAPIDocument(opts OpenAPIOptions) *openapi.Document {
+	doc := openapi.New(openapi.Info{
+		Title:       "Vietnam Administrative API",
+		Description: "Provinces and wards of Vietnam after the 2025 administrative reorganization",
+		Version:     opts.Version,
+	})
+	doc.Tags = []openapi.Tag{
+		{Name: "provinces", Description: "Provinces and centrally-governed cities"},
+		{Name: "wards", Description: "Wards, communes and special zones"},
+		{Name: "search", Description: "Search, hierarchy and export"},
+		{Name: "postal", Description: "Postal codes"},
+		{Name: "geo", Description: "Location by coordinates"},
+		{Name: "utility", Description: "Validation, health and monitoring"},
+		{Name: "admin", Description: "Administration"},
+	}
+
+	// Views serialize only the requested fields, so none is required
+	province := doc.Schema(models.Province{})
+	ward := doc.Schema(models.Ward{})
+	provinceView := doc.Define(models.ProvinceView{}, projected(doc, "Province",
+		"A province with only the fields selected with ?fields="))
+	wardViewSchema := projected(doc, "Ward",
+		"A ward with only the fields selected with ?fields=, and its province with ?include=province")
+	wardViewSchema.Properties["province"] = province
+	wardView := doc.Define(models.WardView{}, wardViewSchema)
+	provinceTreeViewSchema := projected(doc, "Province", "A province of the tree with its wards")
+	provinceTreeViewSchema.Properties["wards"] = openapi.ArrayOf(wardView)
+	doc.Define(models.ProvinceTreeView{}, provinceTreeViewSchema)
+
+	// Query parameters read by parseQueryParams and shared by list endpoints
+	search := doc.DefineParameter("search", query("search", "Case-insensitive match on names, slugs and paths, or on an alias such as TP.HCM", stringSchema()))
+	// Types are the slugs listed by /provinces/types and /wards/types
+	provinceTypes, wardTypes := []string{"tinh", "thanh-pho"}, []string{"phuong", "xa", "dac-khu"}
+	provinceType := doc.DefineParameter("provinceType", query("type", "Exact province type", enumSchema(nil, provinceTypes...)))
+	wardType := doc.DefineParameter("wardType", query("type", "Exact ward type", enumSchema(nil, wardTypes...)))
+	typeFilter := doc.DefineParameter("type", query("type", "Exact province or ward type, depending on the dataset",
+		enumSchema(nil, append(append([]string{}, provinceTypes...), wardTypes...)...)))
+	limit := doc.DefineParameter("limit", query("limit", "Page size", intSchema(50, 1, 1000)))
+	offset := doc.DefineParameter("offset", query("offset", "Number of results to skip; ignored with cursor", intSchema(0, 0, maxOffset)))
+	sort := doc.DefineParameter("sort", query("sort", "Sort field, prefixed with - for descending order",
+		enumSchema("name", "name", "-name", "code", "-code", "type", "-type", "province", "-province")))
+	cursor := doc.DefineParameter("cursor", query("cursor", "Opaque next_cursor of the previous page", stringSchema()))
+	fields := doc.DefineParameter("fields", query("fields", "Comma-separated fields to return", stringSchema()))
+	include := doc.DefineParameter("include", query("include", "Related resources to embed", enumSchema(nil, "province")))
+	provinceCode := doc.DefineParameter("province_code", query("province_code", "Only wards of this province", stringSchema()))
+	imeMethod := doc.DefineParameter("ime", query("ime", "Convert a search typed as raw Telex or VNI keystrokes, such as Hoof Chis Minh, before matching",
+		enumSchema(nil, "telex", "vni", "auto")))
+	region := doc.DefineParameter("region", query("region", "Region or socio-economic region of the province, as listed by /provinces/regions, e.g. mien-bac or tay-nguyen", stringSchema()))
+	listParams := func(types *openapi.Parameter) []*openapi.Parameter {
+		return []*openapi.Parameter{search, imeMethod, types, region, limit, offset, sort, cursor, fields}
+	}
+
+	errorResponse := openapi.JSON("Error", doc.Schema(models.ErrorResponse{}))
+	withErrors := func(responses map[string]*openapi.Response, statuses ...string) map[string]*openapi.Response {
+		for _, status := range statuses {
+			responses[status] = errorResponse
+		}
+		return responses
+	}
+	paginated := func(items *openapi.Schema) *openapi.Schema {
+		return &openapi.Schema{AllOf: []*openapi.Schema{
+			doc.Schema(models.PaginatedResponse{}),
+			{Type: "object", Properties: map[string]*openapi.Schema{"data": openapi.ArrayOf(items)}},
+		}}
+	}
+	wrapped := func(data *openapi.Schema) *openapi.Schema {
+		return &openapi.Schema{AllOf: []*openapi.Schema{
+			doc.Schema(models.APIResponse{}),
+			{Type: "object", Properties: map[string]*openapi.Schema{"data": data}},
+		}}
+	}
+
+	// Provinces
+	doc.Add(http.MethodGet, "/api/v1/provinces", &openapi.Operation{
+		Tags: []string{"provinces"}, Summary: "List provinces", OperationID: "listProvinces",
+		Parameters: listParams(provinceType),
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("A page of provinces", paginated(provinceView)),
+		}, "400", "409", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/provinces/types", &openapi.Operation{
+		Tags: []string{"provinces"}, Summary: "List province types", OperationID: "listProvinceTypes",
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Province types", wrapped(openapi.ArrayOf(stringSchema()))),
+		}, "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/provinces/regions", &openapi.Operation{
+		Tags: []string{"provinces"}, Summary: "List regions and socio-economic regions", OperationID: "listRegions",
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Regions", wrapped(openapi.ArrayOf(stringSchema()))),
+		}, "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/provinces/{code}", &openapi.Operation{
+		Tags: []string{"provinces"}, Summary: "Get a province", OperationID: "getProvince",
+		Parameters: []*openapi.Parameter{path("code", "Province code"), fields},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The province", wrapped(provinceView)),
+		}, "400", "404", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/provinces/{code}/wards", &openapi.Operation{
+		Tags: []string{"provinces", "wards"}, Summary: "List the wards of a province", OperationID: "listProvinceWards",
+		Parameters: append([]*openapi.Parameter{path("code", "Province code")}, append(listParams(wardType), include)...),
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("A page of wards", paginated(wardView)),
+		}, "400", "404", "409", "503"),
+	})
+
+	// Wards
+	doc.Add(http.MethodGet, "/api/v1/wards", &openapi.Operation{
+		Tags: []string{"wards"}, Summary: "List wards", OperationID: "listWards",
+		Parameters: append(listParams(wardType), include, provinceCode),
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("A page of wards", paginated(wardView)),
+		}, "400", "409", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/wards/types", &openapi.Operation{
+		Tags: []string{"wards"}, Summary: "List ward types", OperationID: "listWardTypes",
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Ward types", wrapped(openapi.ArrayOf(stringSchema()))),
+		}, "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/wards/stream", &openapi.Operation{
+		Tags: []string{"wards"}, Summary: "Stream wards as NDJSON", OperationID: "streamWards",
+		Description: "One ward per line, followed by a trailer record with the dataset version and the record count",
+		Parameters:  []*openapi.Parameter{search, wardType, provinceCode, region, sort},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": {
+				Description: "Newline-delimited wards and a trailer",
+				Content: map[string]*openapi.MediaType{"application/x-ndjson": {Schema: &openapi.Schema{
+					OneOf: []*openapi.Schema{ward, doc.Schema(models.StreamTrailer{})},
+				}}},
+			},
+		}, "400", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/wards/{code}", &openapi.Operation{
+		Tags: []string{"wards"}, Summary: "Get a ward with its province", OperationID: "getWard",
+		Parameters: []*openapi.Parameter{path("code", "Ward code"), fields},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The ward", wrapped(wardView)),
+		}, "400", "404", "503"),
+	})
+
+	// Search, hierarchy and export
+	doc.Add(http.MethodGet, "/api/v1/search", &openapi.Operation{
+		Tags: []string{"search"}, Summary: "Search provinces and wards", OperationID: "search",
+		Parameters: []*openapi.Parameter{
+			{Name: "q", In: "query", Required: true, Description: "Search query, at least 2 characters",
+				Schema: &openapi.Schema{Type: "string", MinLength: intPtr(2)}},
+			imeMethod,
+			query("entity", "Entities to search", enumSchema("all", "all", "province", "ward")),
+			query("limit", "Maximum results per entity", intSchema(20, 1, 100)),
+			fields, include,
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Matching provinces and wards", doc.Schema(models.SearchResponse{})),
+		}, "400", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/tree", &openapi.Operation{
+		Tags: []string{"search"}, Summary: "Get the whole province to wards tree", OperationID: "getTree",
+		Parameters: []*openapi.Parameter{
+			query("format", "Nested objects or arrays of field values", enumSchema("nested", "nested", "compact")),
+			query("fields", "Comma-separated fields of provinces and wards, by default code,name,name_with_type", stringSchema()),
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The tree", &openapi.Schema{OneOf: []*openapi.Schema{
+				doc.Schema(models.TreeResponse{}), doc.Schema(models.CompactTreeResponse{}),
+			}}),
+		}, "400", "503"),
+	})
+	fileSchema := &openapi.Schema{Type: "string", Format: "binary"}
+	doc.Add(http.MethodGet, "/api/v1/export", &openapi.Operation{
+		Tags: []string{"search"}, Summary: "Export a table as CSV, TSV or XLSX", OperationID: "export",
+		Parameters: []*openapi.Parameter{
+			query("format", "File format", enumSchema("csv", "csv", "tsv", "xlsx")),
+			query("dataset", "Table to export", enumSchema("wards_with_province", "provinces", "wards", "wards_with_province")),
+			query("bom", "Prefix CSV and TSV with a UTF-8 byte order mark for Excel", &openapi.Schema{Type: "boolean", Default: false}),
+			search, typeFilter, provinceCode, region, sort,
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": {
+				Description: "The exported file",
+				Headers: map[string]*openapi.Header{
+					"Content-Disposition": {Schema: stringSchema()},
+				},
+				Content: map[string]*openapi.MediaType{
+					"text/csv":                  {Schema: fileSchema},
+					"text/tab-separated-values": {Schema: fileSchema},
+					"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": {Schema: fileSchema},
+				},
+			},
+		}, "400", "503"),
+	})
+
+	// Utility
+	doc.Add(http.MethodGet, "/api/v1/postal/{code}", &openapi.Operation{
+		Tags: []string{"postal"}, Summary: "Resolve a postal code", OperationID: "getPostalCode",
+		Description: "The province is resolved from the first two digits; wards are listed when the code is their own postal code",
+		Parameters:  []*openapi.Parameter{path("code", "5-digit postal code")},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The province and wards of the postal code", wrapped(doc.Schema(models.PostalLookup{}))),
+		}, "400", "404", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/wards/{code}/postal", &openapi.Operation{
+		Tags: []string{"postal", "wards"}, Summary: "Get the postal codes of a ward", OperationID: "getWardPostal",
+		Parameters: []*openapi.Parameter{path("code", "Ward code")},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The ward's postal code, when known, and the codes of its province", wrapped(doc.Schema(models.WardPostal{}))),
+		}, "404", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/geo/nearest", &openapi.Operation{
+		Tags: []string{"geo"}, Summary: "Find the wards nearest to a point", OperationID: "nearestWards",
+		Description: "Wards are ranked by the great-circle distance to their centroid; wards without a centroid are not returned. Requires ward centroids; 503 when none are loaded",
+		Parameters: []*openapi.Parameter{
+			{Name: "lat", In: "query", Required: true, Description: "Latitude, WGS 84", Schema: &openapi.Schema{Type: "number"}},
+			{Name: "lng", In: "query", Required: true, Description: "Longitude, WGS 84", Schema: &openapi.Schema{Type: "number"}},
+			query("limit", "Number of wards", intSchema(5, 1, 50)),
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The nearest wards, nearest first", wrapped(openapi.ArrayOf(doc.Schema(models.NearestWard{})))),
+		}, "400", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/geo/reverse", &openapi.Operation{
+		Tags: []string{"geo"}, Summary: "Find the ward containing a point", OperationID: "reverseGeocode",
+		Description: "Requires the optional ward boundary file; 503 when it is not loaded",
+		Parameters: []*openapi.Parameter{
+			{Name: "lat", In: "query", Required: true, Description: "Latitude, WGS 84", Schema: &openapi.Schema{Type: "number"}},
+			{Name: "lng", In: "query", Required: true, Description: "Longitude, WGS 84", Schema: &openapi.Schema{Type: "number"}},
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The ward and its province", wrapped(doc.Schema(models.ReverseGeocode{}))),
+		}, "400", "404", "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/wards/{code}/boundary", &openapi.Operation{
+		Tags: []string{"geo", "wards"}, Summary: "Get the boundary of a ward as GeoJSON", OperationID: "getWardBoundary",
+		Parameters: []*openapi.Parameter{
+			path("code", "Ward code"),
+			query("simplify", "Simplification level: none keeps every point, low, medium and high drop points within about 5, 20 and 110 m",
+				enumSchema("none", "none", "low", "medium", "high")),
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": {
+				Description: "A GeoJSON Feature with a MultiPolygon geometry",
+				Content:     map[string]*openapi.MediaType{"application/geo+json": {Schema: doc.Schema(models.BoundaryFeature{})}},
+			},
+		}, "400", "404", "503"),
+	})
+	doc.Add(http.MethodPost, "/api/v1/address/validate", &openapi.Operation{
+		Tags: []string{"utility"}, Summary: "Check that a ward belongs to a province", OperationID: "validateAddress",
+		Description: "Each unit is given by its code or, without one, by its name or alias, compared ignoring diacritics, case, punctuation and spacing; " +
+			"units designated through an alias have matched_alias set. " +
+			"With postal_code, the address is only valid when the code is the ward's own postal code or, when unknown, a code of its province",
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content:  map[string]*openapi.MediaType{"application/json": {Schema: doc.Schema(models.ValidationRequest{})}},
+		},
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("The validation result", doc.Schema(models.ValidationResponse{})),
+		}, "400", "503"),
+	})
+	health := &openapi.Operation{
+		Tags: []string{"utility"}, Summary: "Health check", OperationID: "health",
+		Responses: map[string]*openapi.Response{
+			"200": openapi.JSON("Healthy", doc.Schema(models.HealthResponse{})),
+			"503": openapi.JSON("Unhealthy", doc.Schema(models.HealthResponse{})),
+		},
+	}
+	doc.Add(http.MethodGet, "/api/v1/health", health)
+	doc.Add(http.MethodGet, "/api/v1/stats", &openapi.Operation{
+		Tags: []string{"utility"}, Summary: "Dataset and cache statistics", OperationID: "stats",
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Statistics", wrapped(&openapi.Schema{Type: "object"})),
+		}, "503"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/openapi.json", &openapi.Operation{
+		Tags: []string{"utility"}, Summary: "This OpenAPI document", OperationID: "openAPI",
+		Responses: map[string]*openapi.Response{
+			"200": openapi.JSON("OpenAPI 3 document", &openapi.Schema{Type: "object"}),
+		},
+	})
+	doc.Add(http.MethodPost, "/api/v1/admin/reload", &openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Reload the data files", OperationID: "reloadData",
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Reloaded", wrapped(&openapi.Schema{Type: "object"})),
+		}, "500"),
+	})
+	doc.Add(http.MethodGet, "/api/v1/admin/aliases", &openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Get the alias dictionary", OperationID: "getAliases",
+		Responses: withErrors(map[string]*openapi.Response{
+			"200": openapi.JSON("Aliases of provinces and wards by code", wrapped(doc.Schema(models.Aliases{}))),
+		}, "503"),
+	})
+	// Alias editing is mounted only when an admin token is configured
+	if opts.AdminAliases {
+		aliasParams := []*openapi.Parameter{
+			{Name: "kind", In: "path", Required: true, Description: "Kind of administrative unit", Schema: enumSchema(nil, "provinces", "wards")},
+			path("code", "Province or ward code"),
+		}
+		doc.Add(http.MethodPut, "/api/v1/admin/aliases/{kind}/{code}", &openapi.Operation{
+			Tags: []string{"admin"}, Summary: "Replace the aliases of a province or ward", OperationID: "setAliases",
+			Parameters: aliasParams,
+			RequestBody: &openapi.RequestBody{
+				Required: true,
+				Content:  map[string]*openapi.MediaType{"application/json": {Schema: doc.Schema(models.AliasesRequest{})}},
+			},
+			Responses: withErrors(map[string]*openapi.Response{
+				"200": openapi.JSON("The saved aliases", wrapped(doc.Schema(models.UnitAliases{}))),
+			}, "400", "401", "404", "500", "503"),
+		})
+		doc.Add(http.MethodDelete, "/api/v1/admin/aliases/{kind}/{code}", &openapi.Operation{
+			Tags: []string{"admin"}, Summary: "Remove the aliases of a province or ward", OperationID: "deleteAliases",
+			Parameters: aliasParams,
+			Responses: withErrors(map[string]*openapi.Response{
+				"200": openapi.JSON("The aliases were removed", wrapped(doc.Schema(models.UnitAliases{}))),
+			}, "400", "401", "404", "500", "503"),
+		})
+	}
+
+	// GraphQL
+	if opts.GraphQL {
+		graphQLResult := openapi.JSON("GraphQL result with data and errors", &openapi.Schema{
+			Type: "object",
+			Properties: map[string]*openapi.Schema{
+				"data":   {Type: "object"},
+				"errors": openapi.ArrayOf(&openapi.Schema{Type: "object"}),
+			},
+		})
+		graphQLResponses := map[string]*openapi.Response{"200": graphQLResult, "400": graphQLResult}
+		doc.Add(http.MethodGet, "/graphql", &openapi.Operation{
+			Tags: []string{"search"}, Summary: "Execute a GraphQL query", OperationID: "graphqlGet",
+			Parameters: []*openapi.Parameter{
+				{Name: "query", In: "query", Required: true, Schema: stringSchema()},
+				query("variables", "JSON object of variables", stringSchema()),
+				query("operationName", "Operation to execute", stringSchema()),
+			},
+			Responses: graphQLResponses,
+		})
+		doc.Add(http.MethodPost, "/graphql", &openapi.Operation{
+			Tags: []string{"search"}, Summary: "Execute a GraphQL query", OperationID: "graphqlPost",
+			RequestBody: &openapi.RequestBody{
+				Required: true,
+				Content: map[string]*openapi.MediaType{"application/json": {Schema: &openapi.Schema{
+					Type:     "object",
+					Required: []string{"query"},
+					Properties: map[string]*openapi.Schema{
+						"query":         stringSchema(),
+						"variables":     {Type: "object"},
+						"operationName": stringSchema(),
+					},
+				}}},
+			},
+			Responses: graphQLResponses,
+		})
+	}
+
+	// Root endpoints
+	doc.Add(http.MethodGet, "/", &openapi.Operation{
+		Tags: []string{"utility"}, Summary: "Service information and endpoint index", OperationID: "root",
+		Responses: map[string]*openapi.Response{
+			"200": openapi.JSON("Service information", &openapi.Schema{Type: "object"}),
+		},
+	})
+	rootHealth := *health
+	rootHealth.OperationID = "rootHealth"
+	doc.Add(http.MethodGet, "/health", &rootHealth)
+	doc.Add(http.MethodGet, "/metrics", &openapi.Operation{
+		Tags: []string{"utility"}, Summary: "Prometheus metrics", OperationID: "metrics",
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "Metrics in the Prometheus text format",
+				Content:     map[string]*openapi.MediaType{"text/plain": {Schema: stringSchema()}},
+			},
+		},
+	})
+
+	// Every route localizes its messages
+	lang := doc.DefineParameter("lang", query(middleware.LanguageParam,
+		"Language of messages, overriding Accept-Language. With en, names and paths are also returned in English", enumSchema(nil, opts.Languages...)))
+	for _, item := range doc.Paths {
+		for _, op := range item {
+			op.Parameters = append(op.Parameters, lang)
+		}
+	}
+
+	return doc
+}
+
+// projected copies a model schema with every property optional
+func projected(doc *openapi.Document, name, description string) *openapi.Schema {
+	model := doc.Components.Schemas[name]
+	schema := &openapi.Schema{Type: "object", Description: description, Properties: map[string]*openapi.Schema{}}
+	for key, property := range model.Properties {
+		schema.Properties[key] = property
+	}
+	return schema
+}
+
+func query(name, description string, schema *openapi.Schema) *openapi.Parameter {
+	return &openapi.Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+func path(name, description string) *openapi.Parameter {
+	return &openapi.Parameter{Name: name, In: "path", Description: description, Required: true, Schema: stringSchema()}
+}
+
+func stringSchema() *openapi.Schema {
+	return &openapi.Schema{Type: "string"}
+}
+
+// intSchema returns an integer schema; a zero max means unbounded
+func intSchema(def, min, max int) *openapi.Schema {
+	schema := &openapi.Schema{Type: "integer", Default: def, Minimum: floatPtr(float64(min))}
+	if max > 0 {
+		schema.Maximum = floatPtr(float64(max))
+	}
+	return schema
+}
+
+// enumSchema returns a string schema accepting values; a nil def means no
+// default
+func enumSchema(def interface{}, values ...string) *openapi.Schema {
+	schema := &openapi.Schema{Type: "string", Default: def}
+	for _, value := range values {
+		schema.Enum = append(schema.Enum, value)
+	}
+	return schema
+}
+
+func intPtr(n int) *int { return &n }
+
+func floatPtr(f float64) *float64 { return &f }
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+)
+
+// GetPostalCode handles GET /api/v1/postal/:code
+//
+// The province is resolved from the leading digits of the code. Wards are
+// listed when the code is their own postal code.
+func (h *APIHandler) GetPostalCode(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	code := strings.TrimSpace(c.Param("code"))
+	if !models.IsPostalCode(code) {
+		h.respondInvalidParameters(c, violation(c, "code", "violation.postal_code"))
+		return
+	}
+
+	lookup, err := h.dataService.LookupPostalCode(c.Request.Context(), code)
+	if err != nil {
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.postal_code_not_found"))
+		return
+	}
+
+	lang := strings.ToLower(strings.TrimSpace(c.Query(middleware.LanguageParam)))
+	province := lookup.Province.Localized(lang)
+	lookup.Province = &province
+	for i, ward := range lookup.Wards {
+		lookup.Wards[i] = ward.Localized(lang)
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    lookup,
+	})
+}
+
+// GetWardPostal handles GET /api/v1/wards/:code/postal
+func (h *APIHandler) GetWardPostal(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	postal, err := h.dataService.GetWardPostal(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		h.respondWithError(c, http.StatusNotFound, models.ErrCodeNotFound, middleware.Message(c, "error.ward_not_found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.APIResponse{
+		Success: true,
+		Data:    postal,
+	})
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+)
+
+// Relations that can be embedded with ?include=
+const includeProvince = "province"
+
+// projection is the response shape requested with ?fields= and ?include=,
+// and the language of names requested with ?lang=
+type projection struct {
+	fields   models.Fields
+	includes map[string]bool
+	lang     string
+}
+
+// parseProjection reads the fields and include parameters. Field names must
+// appear in one of allowedFields and relations in allowedIncludes; anything
+// else is rejected with 400 so typos do not silently return full objects.
+// Names are only translated for an explicit lang, which the Language
+// middleware has already validated; Accept-Language localizes messages alone.
+func (h *APIHandler) parseProjection(c *gin.Context, allowedIncludes []string, allowedFields ...[]string) (projection, bool) {
+	p := projection{lang: strings.ToLower(strings.TrimSpace(c.Query(middleware.LanguageParam)))}
+
+	if names := splitList(c.Query("fields")); len(names) > 0 {
+		p.fields = make(models.Fields, len(names))
+		for _, name := range names {
+			if !containsName(name, allowedFields...) {
+				h.respondInvalidParameters(c, violation(c, "fields", "violation.unknown_field", "name", name))
+				return p, false
+			}
+			p.fields[name] = true
+		}
+	}
+
+	if names := splitList(c.Query("include")); len(names) > 0 {
+		p.includes = make(map[string]bool, len(names))
+		for _, name := range names {
+			if !containsName(name, allowedIncludes) {
+				h.respondInvalidParameters(c, violation(c, "include", "violation.unsupported_include", "name", name))
+				return p, false
+			}
+			p.includes[name] = true
+		}
+	}
+
+	return p, true
+}
+
+// key returns the normalized projection for response cache keys
+func (p projection) key() string {
+	return joinSorted(p.fields) + "|" + joinSorted(p.includes) + "|" + p.lang
+}
+
+// province returns the view of a province with its names in the requested
+// language
+func (p projection) province(province models.Province) models.ProvinceView {
+	return models.ProvinceView{Province: province.Localized(p.lang), Fields: p.fields}
+}
+
+// ward returns the view of a ward with its names in the requested language
+func (p projection) ward(ward models.Ward) models.WardView {
+	return models.WardView{Ward: ward.Localized(p.lang), Fields: p.fields}
+}
+
+// projectProvinces applies the projection to a list of provinces
+func projectProvinces(p projection, list []models.Province) []models.ProvinceView {
+	views := make([]models.ProvinceView, len(list))
+	for i, province := range list {
+		views[i] = p.province(province)
+	}
+	return views
+}
+
+// projectWards applies the projection to a list of wards, embedding their
+// provinces when include=province was requested
+func (h *APIHandler) projectWards(ctx context.Context, p projection, list []models.Ward) []models.WardView {
+	var provinces map[string]models.Province
+	if p.includes[includeProvince] {
+		codes := make([]string, 0, len(list))
+		for _, ward := range list {
+			codes = append(codes, ward.ParentCode)
+		}
+		provinces = h.dataService.GetProvincesByCode(ctx, codes)
+	}
+
+	views := make([]models.WardView, len(list))
+	for i, ward := range list {
+		views[i] = p.ward(ward)
+		if province, ok := provinces[ward.ParentCode]; ok {
+			province = province.Localized(p.lang)
+			views[i].Province = &province
+		}
+	}
+	return views
+}
+
+// splitList splits a comma separated parameter, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func containsName(name string, lists ...[]string) bool {
+	for _, list := range lists {
+		for _, candidate := range list {
+			if candidate == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func joinSorted(set map[string]bool) string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
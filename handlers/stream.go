@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/middleware"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/services"
+)
+
+// streamFlushEvery is the number of records written between flushes
+const streamFlushEvery = 500
+
+// StreamWards handles GET /api/v1/wards/stream
+//
+// Every matching ward is written as one JSON object per line, without the
+// limit applied to paginated endpoints, followed by a trailer record carrying
+// the dataset version and the record count. Output is flushed periodically so
+// clients can consume it while it is being written.
+func (h *APIHandler) StreamWards(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	order, err := services.ParseSortOrder(c.Query("sort"))
+	if err != nil {
+		h.respondInvalidParameters(c, violation(c, "sort", "violation.invalid_sort"))
+		return
+	}
+	search := strings.TrimSpace(c.Query("search"))
+	typeFilter := strings.TrimSpace(c.Query("type"))
+	provinceCode := strings.TrimSpace(c.Query("province_code"))
+	region, ok := h.parseRegion(c)
+	if !ok {
+		return
+	}
+	lang := strings.ToLower(strings.TrimSpace(c.Query(middleware.LanguageParam)))
+
+	ctx := c.Request.Context()
+	wards, version := h.dataService.ListWards(ctx, search, typeFilter, provinceCode, region, order)
+
+	c.Header("Content-Type", "application/x-ndjson; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	for i := range wards {
+		ward := wards[i].Localized(lang)
+		if err := encoder.Encode(&ward); err != nil {
+			logging.FromContext(ctx).Warn("ward stream interrupted", "error", err, "records", i)
+			return
+		}
+		if (i+1)%streamFlushEvery == 0 {
+			c.Writer.Flush()
+		}
+	}
+
+	trailer := models.StreamTrailer{Trailer: models.StreamSummary{Version: version, Count: len(wards)}}
+	if err := encoder.Encode(trailer); err != nil {
+		logging.FromContext(ctx).Warn("ward stream interrupted", "error", err, "records", len(wards))
+		return
+	}
+	c.Writer.Flush()
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/models"
+)
+
+// Tree encodings selected with ?format=
+const (
+	treeFormatNested  = "nested"
+	treeFormatCompact = "compact"
+)
+
+// defaultTreeFields are returned when no fields are requested, keeping the
+// tree small enough to ship to clients in one response
+var defaultTreeFields = models.Fields{"code": true, "name": true, "name_with_type": true}
+
+// GetTree handles GET /api/v1/tree
+//
+// The whole province to wards hierarchy is returned in one response, either
+// nested or, with format=compact, as arrays of field values.
+func (h *APIHandler) GetTree(c *gin.Context) {
+	if !h.checkDataLoaded(c) {
+		return
+	}
+
+	format := strings.ToLower(strings.TrimSpace(c.DefaultQuery("format", treeFormatNested)))
+	if format != treeFormatNested && format != treeFormatCompact {
+		h.respondInvalidParameters(c, violation(c, "format", "violation.one_of", "values", "nested, compact"))
+		return
+	}
+
+	projection, ok := h.parseProjection(c, nil, models.ProvinceFieldNames, models.WardFieldNames)
+	if !ok {
+		return
+	}
+	if projection.fields == nil {
+		projection.fields = defaultTreeFields
+	}
+
+	key := cacheKey(c.FullPath(), format, projection.key())
+	h.respondCached(c, key, func() (interface{}, error) {
+		ctx := c.Request.Context()
+		tree, version := h.dataService.GetTree(ctx)
+		if format == treeFormatCompact {
+			return compactTree(tree, version, projection), nil
+		}
+
+		data := make([]models.ProvinceTreeView, len(tree))
+		for i, node := range tree {
+			data[i] = models.ProvinceTreeView{
+				ProvinceView: projection.province(node.Province),
+				Wards:        h.projectWards(ctx, projection, node.Wards),
+			}
+		}
+		return models.TreeResponse{Success: true, Version: version, Data: data}, nil
+	})
+}
+
+// compactTree encodes the tree as nested arrays of field values
+func compactTree(tree []models.ProvinceWithWards, version string, p projection) models.CompactTreeResponse {
+	data := make([]interface{}, len(tree))
+	for i, node := range tree {
+		wards := make([]interface{}, len(node.Wards))
+		for j, ward := range node.Wards {
+			wards[j] = p.ward(ward).Values()
+		}
+		province := p.province(node.Province).Values()
+		data[i] = append(province, wards)
+	}
+
+	return models.CompactTreeResponse{
+		Success: true,
+		Version: version,
+		Fields: models.CompactFields{
+			Province: models.SelectedFieldNames(models.ProvinceFieldNames, p.fields),
+			Ward:     models.SelectedFieldNames(models.WardFieldNames, p.fields),
+		},
+		Data: data,
+	}
+}
@@ -0,0 +1,155 @@
+// Package i18n localizes user-facing messages from JSON catalogs, one file
+// per language, so that wording can be changed without rebuilding.
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Catalog holds the messages of every loaded language, keyed by language
+// and message key
+type Catalog struct {
+	fallback string
+	messages map[string]map[string]string
+}
+
+// New returns a catalog of the given messages. Messages missing from a
+// language are taken from the fallback language.
+func New(fallback string, messages map[string]map[string]string) *Catalog {
+	if messages[fallback] == nil {
+		messages[fallback] = map[string]string{}
+	}
+	return &Catalog{fallback: fallback, messages: messages}
+}
+
+// Load reads every <lang>.json file of dir, each a flat object of message
+// keys to templates. The fallback language must be present.
+func Load(dir, fallback string) (*Catalog, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(map[string]map[string]string, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		lang := strings.ToLower(strings.TrimSuffix(filepath.Base(file), ".json"))
+		messages[lang] = catalog
+	}
+
+	if _, ok := messages[fallback]; !ok {
+		return nil, fmt.Errorf("no message catalog for fallback language %q in %s", fallback, dir)
+	}
+	return New(fallback, messages), nil
+}
+
+// Fallback returns the language used when no other matches
+func (c *Catalog) Fallback() string {
+	return c.fallback
+}
+
+// Languages returns the loaded languages in alphabetical order
+func (c *Catalog) Languages() []string {
+	languages := make([]string, 0, len(c.messages))
+	for lang := range c.messages {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Supports reports whether lang has a catalog
+func (c *Catalog) Supports(lang string) bool {
+	_, ok := c.messages[lang]
+	return ok
+}
+
+// Match returns the first language of an Accept-Language header that has a
+// catalog, in order of preference, or the fallback language. Regional
+// variants match their base language, so en-US selects en.
+func (c *Catalog) Match(acceptLanguage string) string {
+	type candidate struct {
+		lang    string
+		quality float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if tag == "" || quality <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{strings.ToLower(tag), quality})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+
+	for _, candidate := range candidates {
+		base, _, _ := strings.Cut(candidate.lang, "-")
+		if c.Supports(candidate.lang) {
+			return candidate.lang
+		}
+		if c.Supports(base) {
+			return base
+		}
+	}
+	return c.fallback
+}
+
+// Message returns the message for key in lang, falling back to the fallback
+// language and then to the key itself. Placeholders such as {field} are
+// replaced with the values of args, given as alternating names and values.
+func (c *Catalog) Message(lang, key string, args ...interface{}) string {
+	template, ok := c.messages[lang][key]
+	if !ok {
+		if template, ok = c.messages[c.fallback][key]; !ok {
+			template = key
+		}
+	}
+	if len(args) == 0 {
+		return template
+	}
+
+	replacements := make([]string, 0, len(args))
+	for i := 0; i+1 < len(args); i += 2 {
+		replacements = append(replacements, "{"+fmt.Sprint(args[i])+"}", fmt.Sprint(args[i+1]))
+	}
+	return strings.NewReplacer(replacements...).Replace(template)
+}
+
+var defaultCatalog atomic.Pointer[Catalog]
+
+func init() {
+	defaultCatalog.Store(New("en", map[string]map[string]string{}))
+}
+
+// Default returns the catalog installed with SetDefault. Until then it has no
+// messages and returns keys as they are.
+func Default() *Catalog {
+	return defaultCatalog.Load()
+}
+
+// SetDefault installs the catalog used for responses
+func SetDefault(c *Catalog) {
+	defaultCatalog.Store(c)
+}
@@ -0,0 +1,343 @@
+// Package ime converts Vietnamese typed with the Telex or VNI input methods
+// but left as raw keystrokes, such as "Hoof Chis Minh" or "Ho62 Chi1 Minh",
+// back to Vietnamese.
+package ime
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrUnknownMethod is returned by ParseMethod for unsupported input methods
+var ErrUnknownMethod = errors.New("unknown input method")
+
+// Method names the keystroke convention of the input
+type Method string
+
+// Supported input methods
+const (
+	// Telex marks tones with s, f, r, x, j and vowels with doubled letters or w
+	Telex Method = "telex"
+	// VNI marks tones with 1 to 5 and vowels with 6 to 9
+	VNI Method = "vni"
+	// Auto uses VNI for words containing digits and Telex for the others
+	Auto Method = "auto"
+)
+
+// ParseMethod parses an input method name
+func ParseMethod(s string) (Method, error) {
+	switch m := Method(strings.ToLower(strings.TrimSpace(s))); m {
+	case Telex, VNI, Auto:
+		return m, nil
+	}
+	return "", ErrUnknownMethod
+}
+
+// Convert replaces every word of s typed as keystrokes of the input method
+// with the Vietnamese syllable they produce. Words that are not valid
+// Vietnamese once converted, such as "Minh" or "Ross", are kept as they are,
+// as is everything that is not an ASCII word.
+func Convert(s string, m Method) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isWordRune(runes[j]) {
+			j++
+		}
+		b.WriteString(convertWord(string(runes[i:j]), m))
+		i = j
+	}
+	return b.String()
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// convertWord converts one word, returning it unchanged when it is not made
+// of keystrokes of the method
+func convertWord(word string, m Method) string {
+	lower := strings.ToLower(word)
+	hasDigit := false
+	for _, r := range lower {
+		if r > unicode.MaxASCII {
+			return word
+		}
+		if r >= '0' && r <= '9' {
+			hasDigit = true
+		}
+	}
+
+	var s *syllable
+	switch {
+	case m == VNI || (m == Auto && hasDigit):
+		s = parseVNI(lower)
+	case m == Telex || m == Auto:
+		s = parseTelex(lower)
+	}
+	if s == nil || !s.changed {
+		return word
+	}
+	converted, ok := s.render()
+	if !ok {
+		return word
+	}
+	return matchCase(converted, word)
+}
+
+// tone is one of the six Vietnamese tones, level being unmarked
+type tone rune
+
+// Tones, as the combining marks that write them
+const (
+	level tone = 0
+	acute tone = '\u0301' // sắc
+	grave tone = '\u0300' // huyền
+	hook  tone = '\u0309' // hỏi
+	tilde tone = '\u0303' // ngã
+	dot   tone = '\u0323' // nặng
+)
+
+// Vowel modifiers; the horn is applied by applyHorn, as uo takes it twice
+var (
+	circumflex = map[rune]rune{'a': 'â', 'e': 'ê', 'o': 'ô'}
+	breve      = map[rune]rune{'a': 'ă'}
+)
+
+var (
+	telexTones = map[rune]tone{'s': acute, 'f': grave, 'r': hook, 'x': tilde, 'j': dot, 'z': level}
+	vniTones   = map[rune]tone{'1': acute, '2': grave, '3': hook, '4': tilde, '5': dot, '0': level}
+)
+
+// syllable is a word being decoded: its letters with vowel modifiers applied
+// and its tone, which is placed when rendering
+type syllable struct {
+	letters []rune
+	tone    tone
+	changed bool
+}
+
+// parseTelex decodes Telex keystrokes, returning nil when a key cannot apply
+func parseTelex(word string) *syllable {
+	s := &syllable{}
+	for _, r := range word {
+		switch {
+		case r == 'd' && len(s.letters) == 1 && s.letters[0] == 'd':
+			s.letters[0] = 'đ'
+		case telexTones[r] != level && s.lastVowel() >= 0:
+			// Typing the same tone key twice writes the letter itself,
+			// which no Vietnamese syllable ends with
+			if s.tone == telexTones[r] {
+				return nil
+			}
+			s.tone = telexTones[r]
+		case r == 'z' && s.lastVowel() >= 0:
+			s.tone = level
+		case circumflex[r] != 0:
+			if i := s.lastVowel(); i >= 0 && s.letters[i] == r {
+				s.letters[i] = circumflex[r]
+			} else {
+				s.letters = append(s.letters, r)
+				continue
+			}
+		case r == 'w':
+			// w modifies the last vowel, or is ư on its own
+			i := s.lastVowel()
+			switch {
+			case i < 0:
+				s.letters = append(s.letters, 'ư')
+			case s.letters[i] == 'a':
+				s.letters[i] = 'ă'
+			case !s.applyHorn():
+				return nil
+			}
+		default:
+			s.letters = append(s.letters, r)
+			continue
+		}
+		s.changed = true
+	}
+	return s
+}
+
+// parseVNI decodes VNI keystrokes, returning nil when a key cannot apply
+func parseVNI(word string) *syllable {
+	s := &syllable{}
+	for _, r := range word {
+		t, isTone := vniTones[r]
+		switch {
+		case isTone:
+			if s.lastVowel() < 0 {
+				return nil
+			}
+			s.tone = t
+		case r == '6':
+			if !s.apply(circumflex) {
+				return nil
+			}
+		case r == '7':
+			if !s.applyHorn() {
+				return nil
+			}
+		case r == '8':
+			if !s.apply(breve) {
+				return nil
+			}
+		case r == '9':
+			if len(s.letters) == 0 || s.letters[0] != 'd' {
+				return nil
+			}
+			s.letters[0] = 'đ'
+		case r >= '0' && r <= '9':
+			return nil
+		default:
+			s.letters = append(s.letters, r)
+			continue
+		}
+		s.changed = true
+	}
+	return s
+}
+
+// lastVowel returns the index of the last vowel, or -1
+func (s *syllable) lastVowel() int {
+	for i := len(s.letters) - 1; i >= 0; i-- {
+		if isVowel(s.letters[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// apply modifies the last vowel that the modifier applies to
+func (s *syllable) apply(modifier map[rune]rune) bool {
+	for i := len(s.letters) - 1; i >= 0; i-- {
+		if modified, ok := modifier[s.letters[i]]; ok {
+			s.letters[i] = modified
+			return true
+		}
+	}
+	return false
+}
+
+// applyHorn adds the horn to the last o or u, and to both letters of uo,
+// which is always written ươ
+func (s *syllable) applyHorn() bool {
+	for i := len(s.letters) - 1; i >= 0; i-- {
+		switch s.letters[i] {
+		case 'o':
+			s.letters[i] = 'ơ'
+			if i > 0 && s.letters[i-1] == 'u' {
+				s.letters[i-1] = 'ư'
+			}
+			return true
+		case 'u':
+			s.letters[i] = 'ư'
+			return true
+		}
+	}
+	return false
+}
+
+// Consonants that may start and end a syllable, longest first
+var (
+	initials = []string{"ngh", "ch", "gh", "gi", "kh", "ng", "nh", "ph", "qu", "th", "tr",
+		"b", "c", "d", "đ", "g", "h", "k", "l", "m", "n", "p", "r", "s", "t", "v", "x", ""}
+	finals = map[string]bool{"": true, "c": true, "ch": true, "m": true, "n": true, "ng": true, "nh": true, "p": true, "t": true}
+)
+
+// render writes the syllable with its tone placed, reporting whether it is a
+// valid Vietnamese syllable
+func (s *syllable) render() (string, bool) {
+	word := string(s.letters)
+
+	initial := ""
+	for _, candidate := range initials {
+		if strings.HasPrefix(word, candidate) {
+			initial = candidate
+			break
+		}
+	}
+	rest := []rune(word[len(initial):])
+	// In gi and qu followed by a vowel, the i and u belong to the initial;
+	// alone, as in gì, the i is the vowel
+	if (initial == "gi" || initial == "qu") && (len(rest) == 0 || !isVowel(rest[0])) {
+		initial = initial[:1]
+		rest = []rune(word[len(initial):])
+	}
+
+	n := 0
+	for n < len(rest) && isVowel(rest[n]) {
+		n++
+	}
+	nucleus, final := rest[:n], string(rest[n:])
+	if n == 0 || n > 3 || !finals[final] {
+		return "", false
+	}
+	// Syllables ending in a stop only take the sắc and nặng tones
+	if (final == "c" || final == "ch" || final == "p" || final == "t") && s.tone != acute && s.tone != dot {
+		return "", false
+	}
+
+	if s.tone != level {
+		i := toneIndex(nucleus, final != "")
+		marked := norm.NFC.String(string(nucleus[i]) + string(rune(s.tone)))
+		nucleus = append(append(append([]rune{}, nucleus[:i]...), []rune(marked)...), nucleus[i+1:]...)
+	}
+	return initial + string(nucleus) + final, true
+}
+
+// toneIndex returns the vowel of the nucleus carrying the tone mark, following
+// the traditional placement of the dataset: hòa and thủy rather than hoà and
+// thuỷ
+func toneIndex(nucleus []rune, closed bool) int {
+	// ươ carries the tone on ơ, other modified vowels on themselves
+	for i := len(nucleus) - 1; i >= 0; i-- {
+		if strings.ContainsRune("ăâêôơư", nucleus[i]) {
+			return i
+		}
+	}
+	switch {
+	case len(nucleus) == 3:
+		return 1
+	case len(nucleus) == 2 && closed:
+		return 1
+	}
+	return 0
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aăâeêioôơuưy", r)
+}
+
+// matchCase applies the capitalization of the keystrokes to the converted word
+func matchCase(converted, original string) string {
+	letters := 0
+	upper := 0
+	for _, r := range original {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	switch {
+	case letters > 1 && upper == letters:
+		return strings.ToUpper(converted)
+	case upper > 0 && unicode.IsUpper([]rune(original)[0]):
+		runes := []rune(converted)
+		runes[0] = unicode.ToUpper(runes[0])
+		return string(runes)
+	}
+	return converted
+}
@@ -0,0 +1,75 @@
+package ime
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		input  string
+		method Method
+		want   string
+	}{
+		// Telex tones and vowels
+		{"Hoof Chis Minh", Telex, "Hồ Chí Minh"},
+		{"Dduwcs", Telex, "Đức"},
+		{"Nguyeenx", Telex, "Nguyễn"},
+		{"Bawcs Ninh", Telex, "Bắc Ninh"},
+		{"Ddaf Nawngx", Telex, "Đà Nẵng"},
+		{"Quaajn", Telex, "Quận"},
+		{"Dduwowcj", Telex, "Được"},
+		{"huwowng", Telex, "hương"},
+		{"Thanh Hoas", Telex, "Thanh Hóa"},
+		{"Khanhs Hoaf", Telex, "Khánh Hòa"},
+		{"Thuyr", Telex, "Thủy"},
+		{"gif", Telex, "gì"},
+		{"Xoanf", Telex, "Xoàn"},
+		{"Khoais", Telex, "Khoái"},
+		{"Laof Cai", Telex, "Lào Cai"},
+		{"Hoaf Bifnh", Telex, "Hòa Bình"},
+		{"w", Telex, "ư"},
+		{"HOOF", Telex, "HỒ"},
+		{"Haf Nooij", Telex, "Hà Nội"},
+		{"hoasz", Telex, "hoa"},
+
+		// VNI tones and vowels
+		{"Ho62 Chi1 Minh", VNI, "Hồ Chí Minh"},
+		{"D9u7c1", VNI, "Đức"},
+		{"Nguye6n4", VNI, "Nguyễn"},
+		{"Ba8c1 Ninh", VNI, "Bắc Ninh"},
+		{"D9uo7c5", VNI, "Được"},
+		{"Qua65n 1", VNI, "Quận 1"},
+
+		// Auto picks VNI for words with digits
+		{"Ho62 Chis Minh", Auto, "Hồ Chí Minh"},
+		{"Phuwowngf 12", Auto, "Phường 12"},
+
+		// Words that are not keystrokes are kept
+		{"Minh", Telex, "Minh"},
+		{"Ross", Telex, "Ross"},
+		{"Saigon", Telex, "Saigon"},
+		{"Ward", Telex, "Ward"},
+		{"Bus", Telex, "Bú"},
+		{"Hồ Chí Minh", Telex, "Hồ Chí Minh"},
+		{"TP.HCM", Auto, "TP.HCM"},
+		{"Batf", Telex, "Batf"},
+		{"Q1", VNI, "Q1"},
+		{"Hoof", VNI, "Hoof"},
+		{"", Auto, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Convert(tt.input, tt.method); got != tt.want {
+			t.Errorf("Convert(%q, %s) = %q, want %q", tt.input, tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	for _, s := range []string{"telex", "VNI", " auto "} {
+		if _, err := ParseMethod(s); err != nil {
+			t.Errorf("ParseMethod(%q) failed: %v", s, err)
+		}
+	}
+	if _, err := ParseMethod("viqr"); err != ErrUnknownMethod {
+		t.Errorf("Expected ErrUnknownMethod, got %v", err)
+	}
+}
@@ -2,51 +2,89 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 
+	"vietnam-admin-api/cache"
+	"vietnam-admin-api/gql"
+	"vietnam-admin-api/grpcserver"
 	"vietnam-admin-api/handlers"
+	"vietnam-admin-api/i18n"
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/metrics"
 	"vietnam-admin-api/middleware"
 	"vietnam-admin-api/services"
+	"vietnam-admin-api/tracing"
 )
 
 const (
 	Version         = "1.0.0"
 	DefaultPort     = "8100"
+	DefaultGRPCPort = "9100"
 	DefaultDataPath = "./data"
+	DefaultLocales  = "./locales"
 )
 
 func main() {
-	log.Println("🚀 Starting Vietnam Administrative API Server...")
-
 	// Get configuration from environment
-	port := getEnv("PORT", DefaultPort)
-	dataPath := getEnv("DATA_PATH", DefaultDataPath)
-	ginMode := getEnv("GIN_MODE", "release")
+	config := loadConfig()
+	port := config.Port
+
+	logging.Setup(os.Stdout, config.LogFormat, config.LogLevel)
+	slog.Info("starting Vietnam Administrative API server", "version", Version)
 
 	// Set Gin mode
-	gin.SetMode(ginMode)
+	gin.SetMode(config.GinMode)
+
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), config.Tracing)
+	if err != nil {
+		fatal("failed to setup tracing", err)
+	}
+
+	// Load message catalogs
+	catalog, err := i18n.Load(config.LocalesPath, config.DefaultLanguage)
+	if err != nil {
+		fatal("failed to load message catalogs", err)
+	}
+	i18n.SetDefault(catalog)
 
 	// Initialize data service
-	dataService := services.NewDataService(dataPath)
+	dataService := services.NewDataService(config.DataPath)
 
 	// Load data on startup
-	log.Println("📊 Loading administrative data...")
 	if err := dataService.LoadData(); err != nil {
-		log.Fatalf("❌ Failed to load data: %v", err)
+		fatal("failed to load data", err)
 	}
 
 	// Initialize handlers
 	apiHandler := handlers.NewAPIHandler(dataService, Version)
+	if config.CacheEnabled {
+		apiHandler.UseCache(cache.NewLRU(config.CacheMaxBytes))
+	}
 
 	// Setup Gin router
-	router := setupRouter(apiHandler)
+	config.Compression.Version = dataService.GetChecksum
+	config.HTTPCache.Checksum = dataService.GetChecksum
+	config.HTTPCache.LastModified = dataService.GetLoadTime
+	if getEnvBool("GRAPHQL_ENABLED", true) {
+		schema, err := gql.NewSchema(dataService)
+		if err != nil {
+			fatal("failed to build GraphQL schema", err)
+		}
+		config.GraphQL.Schema = &schema
+	}
+	router := setupRouter(apiHandler, config)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -55,43 +93,198 @@ func main() {
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
+		ErrorLog:     slog.NewLogLogger(slog.Default().Handler(), slog.LevelError),
 	}
 
 	// Start server in a goroutine
 	go func() {
-		log.Printf("🌐 Server starting on port %s", port)
-		log.Printf("📚 Health check: http://localhost:%s/api/v1/health", port)
-		log.Printf("📖 API docs: http://localhost:%s/api/v1/stats", port)
+		slog.Info("server starting",
+			"port", port,
+			"health", "http://localhost:"+port+"/api/v1/health",
+			"stats", "http://localhost:"+port+"/api/v1/stats",
+		)
 
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("❌ Server failed to start: %v", err)
+			fatal("server failed to start", err)
 		}
 	}()
 
+	// Start the gRPC server on its own port, sharing the data service
+	var grpcServer *grpc.Server
+	if config.GRPCEnabled {
+		listener, err := net.Listen("tcp", ":"+config.GRPCPort)
+		if err != nil {
+			fatal("failed to listen for gRPC", err)
+		}
+		grpcServer = grpcserver.New(dataService)
+		go func() {
+			slog.Info("gRPC server starting", "port", config.GRPCPort)
+			if err := grpcServer.Serve(listener); err != nil {
+				fatal("gRPC server failed", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	log.Println("🔄 Shutting down server...")
+	slog.Info("shutting down server")
 
 	// Give outstanding requests 30 seconds to complete
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	if grpcServer != nil {
+		stopGRPC(ctx, grpcServer)
+	}
 	if err := server.Shutdown(ctx); err != nil {
-		log.Fatalf("❌ Server forced to shutdown: %v", err)
+		fatal("server forced to shutdown", err)
+	}
+
+	// Flush pending spans after the last request has finished
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("failed to shutdown tracing", "error", err)
+	}
+
+	slog.Info("server exited")
+}
+
+// stopGRPC waits for in-flight RPCs and streams to finish, cancelling them
+// once ctx is done
+func stopGRPC(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Warn("gRPC server forced to stop")
+		server.Stop()
 	}
+}
+
+// fatal logs an error and exits the process
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// Config holds the runtime configuration read from the environment
+type Config struct {
+	Port      string
+	DataPath  string
+	GinMode   string
+	LogFormat string
+	LogLevel  string
+
+	LocalesPath     string
+	DefaultLanguage string
+
+	CacheEnabled  bool
+	CacheMaxBytes int64
+
+	GRPCEnabled bool
+	GRPCPort    string
 
-	log.Println("✅ Server exited")
+	// AdminToken is the bearer token of the admin endpoints that write to
+	// the data directory; they are not mounted when it is empty
+	AdminToken string
+
+	CORS        middleware.CORSConfig
+	Compression middleware.CompressionConfig
+	HTTPCache   middleware.HTTPCacheConfig
+	Tracing     tracing.Config
+	GraphQL     gql.Config
 }
 
-func setupRouter(apiHandler *handlers.APIHandler) *gin.Engine {
+// loadConfig reads the configuration from environment variables
+func loadConfig() Config {
+	cors := middleware.DefaultCORSConfig()
+	cors.AllowOrigins = getEnvList("CORS_ALLOWED_ORIGINS", cors.AllowOrigins)
+	cors.AllowMethods = getEnvList("CORS_ALLOWED_METHODS", cors.AllowMethods)
+	cors.AllowHeaders = getEnvList("CORS_ALLOWED_HEADERS", cors.AllowHeaders)
+	cors.ExposeHeaders = getEnvList("CORS_EXPOSED_HEADERS", cors.ExposeHeaders)
+	cors.AllowCredentials = getEnvBool("CORS_ALLOW_CREDENTIALS", cors.AllowCredentials)
+	cors.MaxAge = getEnvDuration("CORS_MAX_AGE", cors.MaxAge)
+
+	compression := middleware.DefaultCompressionConfig()
+	compression.MinSize = getEnvInt("COMPRESSION_MIN_SIZE", compression.MinSize)
+	compression.GzipLevel = getEnvInt("COMPRESSION_GZIP_LEVEL", compression.GzipLevel)
+	compression.Brotli = getEnvBool("COMPRESSION_BROTLI", compression.Brotli)
+	compression.BrotliLevel = getEnvInt("COMPRESSION_BROTLI_LEVEL", compression.BrotliLevel)
+	compression.CacheMaxBytes = getEnvInt("COMPRESSION_CACHE_MAX_BYTES", compression.CacheMaxBytes)
+	// Exports and streams are written incrementally, while compression
+	// buffers whole responses
+	compression.ExcludedPaths = []string{"/api/v1/export", "/api/v1/wards/stream"}
+
+	graphqlLimits := gql.DefaultLimits()
+	graphqlLimits.MaxDepth = getEnvInt("GRAPHQL_MAX_DEPTH", graphqlLimits.MaxDepth)
+	graphqlLimits.MaxComplexity = getEnvInt("GRAPHQL_MAX_COMPLEXITY", graphqlLimits.MaxComplexity)
+
+	ginMode := getEnv("GIN_MODE", "release")
+
+	return Config{
+		Port:      getEnv("PORT", DefaultPort),
+		DataPath:  getEnv("DATA_PATH", DefaultDataPath),
+		GinMode:   ginMode,
+		LogFormat: getEnv("LOG_FORMAT", "json"),
+		LogLevel:  getEnv("LOG_LEVEL", "info"),
+
+		LocalesPath:     getEnv("LOCALES_PATH", DefaultLocales),
+		DefaultLanguage: getEnv("DEFAULT_LANGUAGE", "en"),
+
+		CacheEnabled:  getEnvBool("CACHE_ENABLED", true),
+		CacheMaxBytes: int64(getEnvInt("CACHE_MAX_BYTES", 64<<20)),
+
+		GRPCEnabled: getEnvBool("GRPC_ENABLED", true),
+		GRPCPort:    getEnv("GRPC_PORT", DefaultGRPCPort),
+
+		AdminToken: getEnv("ADMIN_TOKEN", ""),
+
+		CORS:        cors,
+		Compression: compression,
+		HTTPCache: middleware.HTTPCacheConfig{
+			Paths:        []string{"/api/v1/provinces", "/api/v1/wards", "/api/v1/search", "/api/v1/tree"},
+			CacheControl: getEnv("CACHE_CONTROL", "public, max-age=300"),
+		},
+		Tracing: tracing.Config{
+			Exporter:       getEnv("TRACING_EXPORTER", tracing.ExporterNone),
+			Endpoint:       getEnv("TRACING_OTLP_ENDPOINT", ""),
+			Insecure:       getEnvBool("TRACING_OTLP_INSECURE", true),
+			FilePath:       getEnv("TRACING_FILE", "traces.jsonl"),
+			SampleRatio:    getEnvFloat("TRACING_SAMPLE_RATIO", 1),
+			ServiceName:    getEnv("OTEL_SERVICE_NAME", "vietnam-admin-api"),
+			ServiceVersion: Version,
+		},
+		GraphQL: gql.Config{
+			Limits: graphqlLimits,
+			// The playground is a development tool, off by default in release mode
+			GraphiQL: getEnvBool("GRAPHIQL_ENABLED", ginMode != gin.ReleaseMode),
+		},
+	}
+}
+
+func setupRouter(apiHandler *handlers.APIHandler, config Config) *gin.Engine {
 	router := gin.New()
 
 	// Middleware
-	router.Use(gin.Recovery())
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Tracing())
 	router.Use(middleware.Logger())
-	router.Use(middleware.CORS())
+	router.Use(middleware.Recovery())
+	router.Use(middleware.Metrics())
+	router.Use(middleware.CORS(config.CORS))
+	router.Use(middleware.Language())
+	if config.HTTPCache.Checksum != nil {
+		router.Use(middleware.HTTPCache(config.HTTPCache))
+	}
+	if getEnvBool("COMPRESSION_ENABLED", true) {
+		router.Use(middleware.Compression(config.Compression))
+	}
 
 	// Rate limiting (optional - uncomment if needed)
 	// router.Use(middleware.RateLimit())
@@ -104,6 +297,7 @@ func setupRouter(apiHandler *handlers.APIHandler) *gin.Engine {
 		{
 			provinces.GET("", apiHandler.GetProvinces)
 			provinces.GET("/types", apiHandler.GetProvinceTypes)
+			provinces.GET("/regions", apiHandler.GetRegions)
 			provinces.GET("/:code", apiHandler.GetProvince)
 			provinces.GET("/:code/wards", apiHandler.GetProvinceWards)
 		}
@@ -113,27 +307,68 @@ func setupRouter(apiHandler *handlers.APIHandler) *gin.Engine {
 		{
 			wards.GET("", apiHandler.GetWards)
 			wards.GET("/types", apiHandler.GetWardTypes)
+			wards.GET("/stream", apiHandler.StreamWards)
 			wards.GET("/:code", apiHandler.GetWard)
+			wards.GET("/:code/postal", apiHandler.GetWardPostal)
+			wards.GET("/:code/boundary", apiHandler.GetWardBoundary)
 		}
 
 		// Search endpoints
 		v1.GET("/search", apiHandler.GlobalSearch)
 
+		// Hierarchy endpoints
+		v1.GET("/tree", apiHandler.GetTree)
+
+		// Postal code endpoints
+		v1.GET("/postal/:code", apiHandler.GetPostalCode)
+
+		// Geographic endpoints
+		geo := v1.Group("/geo")
+		{
+			geo.GET("/nearest", apiHandler.NearestWards)
+			geo.GET("/reverse", apiHandler.ReverseGeocode)
+		}
+
+		// Export endpoints
+		v1.GET("/export", apiHandler.Export)
+
 		// Utility endpoints
 		v1.POST("/address/validate", apiHandler.ValidateAddress)
 		v1.GET("/health", apiHandler.Health)
 		v1.GET("/stats", apiHandler.Stats)
+		v1.GET("/openapi.json", apiHandler.OpenAPI(handlers.NewOpenAPIDocument(handlers.OpenAPIOptions{
+			Version:      Version,
+			GraphQL:      config.GraphQL.Schema != nil,
+			Languages:    i18n.Default().Languages(),
+			AdminAliases: config.AdminToken != "",
+		})))
 
 		// Admin endpoints (can be protected with auth middleware)
 		admin := v1.Group("/admin")
-		// admin.Use(middleware.AdminAuth()) // Uncomment for auth
+		// admin.Use(middleware.AdminAuth(config.AdminToken)) // Uncomment for auth
 		{
 			admin.POST("/reload", apiHandler.ReloadData)
+			admin.GET("/aliases", apiHandler.GetAliases)
+		}
+		// Editing aliases rewrites aliases.json, so it always requires the
+		// admin token and is disabled without one
+		if config.AdminToken != "" {
+			aliases := admin.Group("/aliases", middleware.AdminAuth(config.AdminToken))
+			aliases.PUT("/:kind/:code", apiHandler.SetAliases)
+			aliases.DELETE("/:kind/:code", apiHandler.DeleteAliases)
 		}
 	}
 
+	// GraphQL endpoint
+	if config.GraphQL.Schema != nil {
+		graphqlHandler := gql.Handler(config.GraphQL)
+		router.GET("/graphql", graphqlHandler)
+		router.POST("/graphql", graphqlHandler)
+	}
+
 	// Root endpoints
 	router.GET("/health", apiHandler.Health)
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
 	router.GET("/", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
 			"service": "Vietnam Administrative API",
@@ -146,7 +381,12 @@ func setupRouter(apiHandler *handlers.APIHandler) *gin.Engine {
 				"provinces": "/api/v1/provinces",
 				"wards":     "/api/v1/wards",
 				"search":    "/api/v1/search",
+				"tree":      "/api/v1/tree",
+				"export":    "/api/v1/export",
 				"validate":  "/api/v1/address/validate",
+				"openapi":   "/api/v1/openapi.json",
+				"metrics":   "/metrics",
+				"graphql":   "/graphql",
 			},
 		})
 	})
@@ -163,3 +403,55 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvList reads a comma-separated list, dropping empty entries
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	list := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if parsed, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return parsed
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if parsed, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return parsed
+	}
+	return defaultValue
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if parsed, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return parsed
+	}
+	return defaultValue
+}
+
+// getEnvDuration accepts Go durations ("12h") or a plain number of seconds
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if parsed, err := time.ParseDuration(value); err == nil {
+		return parsed
+	}
+	return defaultValue
+}
@@ -1,16 +1,47 @@
 package main
 
 import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"vietnam-admin-api/gql"
+	"vietnam-admin-api/grpcserver"
 	"vietnam-admin-api/handlers"
+	"vietnam-admin-api/i18n"
+	"vietnam-admin-api/metrics"
+	"vietnam-admin-api/middleware"
 	"vietnam-admin-api/models"
+	"vietnam-admin-api/openapi"
+	adminv1 "vietnam-admin-api/proto/vietnamadmin/v1"
 	"vietnam-admin-api/services"
+	"vietnam-admin-api/tracing"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 func setupTestRouter() *gin.Engine {
@@ -24,6 +55,8 @@ func setupTestRouter() *gin.Engine {
 
 	// Setup router
 	router := gin.New()
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Metrics())
 
 	v1 := router.Group("/api/v1")
 	{
@@ -38,6 +71,48 @@ func setupTestRouter() *gin.Engine {
 	return router
 }
 
+// loadDataset loads the committed dataset and returns it with a router using
+// the default configuration. The dataset is part of the repository, so a
+// load failure fails the test instead of skipping it.
+func loadDataset(t *testing.T) (*services.DataService, *gin.Engine) {
+	t.Helper()
+	return loadDatasetFrom(t, "./data")
+}
+
+// loadPatchedDataset is loadDataset on a temporary copy of the named data
+// files, each passed through patch when it is not nil. Files missing from the
+// dataset reach patch as nil and are left out unless it returns content.
+func loadPatchedDataset(t *testing.T, files []string, patch func(name string, data []byte) []byte) (*services.DataService, *gin.Engine) {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join("./data", name))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+		if patch != nil {
+			data = patch(name, data)
+		}
+		if data == nil {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return loadDatasetFrom(t, dir)
+}
+
+func loadDatasetFrom(t *testing.T, dir string) (*services.DataService, *gin.Engine) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	dataService := services.NewDataService(dir)
+	if err := dataService.LoadData(); err != nil {
+		t.Fatalf("Failed to load data: %v", err)
+	}
+	return dataService, setupRouter(handlers.NewAPIHandler(dataService, "test"), loadConfig())
+}
+
 func TestHealthEndpoint(t *testing.T) {
 	router := setupTestRouter()
 
@@ -119,3 +194,1576 @@ func BenchmarkProvincesEndpoint(b *testing.B) {
 		router.ServeHTTP(w, req)
 	}
 }
+
+func TestCORSPolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	config := middleware.DefaultCORSConfig()
+	config.AllowOrigins = []string{"https://app.example.com", "https://*.example.org"}
+	config.AllowCredentials = true
+
+	router := gin.New()
+	router.Use(middleware.CORS(config))
+	router.GET("/ping", func(c *gin.Context) { c.String(http.StatusOK, "pong") })
+
+	tests := []struct {
+		origin      string
+		allowed     bool
+		credentials bool
+	}{
+		{"https://app.example.com", true, true},
+		{"https://api.example.org", true, true},
+		{"https://example.org", false, false},
+		{"https://evil.com", false, false},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("OPTIONS", "/ping", nil)
+		req.Header.Set("Origin", tt.origin)
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		router.ServeHTTP(w, req)
+
+		gotOrigin := w.Header().Get("Access-Control-Allow-Origin")
+		if tt.allowed {
+			if w.Code != http.StatusNoContent || gotOrigin != tt.origin {
+				t.Errorf("%s: expected 204 echoing origin, got %d %q", tt.origin, w.Code, gotOrigin)
+			}
+			if w.Header().Get("Vary") == "" {
+				t.Errorf("%s: expected Vary header", tt.origin)
+			}
+		} else if w.Code != http.StatusForbidden || gotOrigin != "" {
+			t.Errorf("%s: expected 403 without allow origin, got %d %q", tt.origin, w.Code, gotOrigin)
+		}
+
+		if got := w.Header().Get("Access-Control-Allow-Credentials") == "true"; got != tt.credentials {
+			t.Errorf("%s: expected credentials=%v, got %v", tt.origin, tt.credentials, got)
+		}
+	}
+
+	// A wildcard origin never grants credentials
+	config.AllowOrigins = []string{"*"}
+	router = gin.New()
+	router.Use(middleware.CORS(config))
+	router.GET("/ping", func(c *gin.Context) { c.String(http.StatusOK, "pong") })
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/ping", nil)
+	req.Header.Set("Origin", "https://any.site")
+	router.ServeHTTP(w, req)
+
+	if w.Header().Get("Access-Control-Allow-Origin") != "https://any.site" {
+		t.Errorf("Expected origin to be echoed, got %q", w.Header().Get("Access-Control-Allow-Origin"))
+	}
+	if w.Header().Get("Access-Control-Allow-Credentials") != "" {
+		t.Errorf("Expected no credentials for wildcard origin")
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	router := setupTestRouter()
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/provinces/11", nil)
+	router.ServeHTTP(w, req)
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/metrics", nil)
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		"# TYPE vietnam_admin_http_requests_total counter",
+		`vietnam_admin_http_requests_total{method="GET",route="/api/v1/provinces/:code"`,
+		"# TYPE vietnam_admin_http_request_duration_seconds histogram",
+		"vietnam_admin_http_requests_in_flight",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected metrics output to contain %q", want)
+		}
+	}
+}
+
+func TestRequestIDPropagation(t *testing.T) {
+	router := setupTestRouter()
+
+	// Client supplied IDs are echoed back and included in error responses
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/search?q=a", nil)
+	req.Header.Set("X-Request-ID", "test-request-1")
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get("X-Request-ID"); got != "test-request-1" {
+		t.Errorf("Expected X-Request-ID to be echoed, got %q", got)
+	}
+
+	var response models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if response.Success || response.Error.RequestID != "test-request-1" {
+		t.Errorf("Expected error response with request_id, got %+v", response)
+	}
+
+	// Missing or malformed IDs are replaced with a generated one
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/health", nil)
+	req.Header.Set("X-Request-ID", "bad id\n")
+	router.ServeHTTP(w, req)
+
+	if got := w.Header().Get("X-Request-ID"); got == "" || got == "bad id\n" {
+		t.Errorf("Expected a generated request ID, got %q", got)
+	}
+}
+
+func TestTracingPropagation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	if _, err := tracing.Setup(context.Background(), tracing.Config{Exporter: tracing.ExporterNone}); err != nil {
+		t.Fatalf("Failed to setup tracing: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Tracing())
+	router.GET("/api/v1/wards/:code", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/wards/267", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(w, req)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if got := spans[0].Name(); got != "GET /api/v1/wards/:code" {
+		t.Errorf("Expected span named after route template, got %q", got)
+	}
+	if got := spans[0].SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected trace ID from traceparent header, got %s", got)
+	}
+}
+
+func TestCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	config := middleware.DefaultCompressionConfig()
+	config.Brotli = true
+	config.Version = func() string { return "v1" }
+
+	calls := 0
+	large := strings.Repeat(`{"name":"Phường Bến Thành"},`, 100)
+	router := gin.New()
+	router.Use(middleware.Compression(config))
+	router.GET("/large", middleware.Cacheable(), func(c *gin.Context) {
+		calls++
+		c.String(http.StatusOK, large)
+	})
+	router.GET("/small", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+
+	request := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request("/large", "gzip")
+	if w.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("Expected gzip encoding, got %q", w.Header().Get("Content-Encoding"))
+	}
+	reader, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatalf("Failed to read gzip body: %v", err)
+	}
+	body, _ := io.ReadAll(reader)
+	if string(body) != large {
+		t.Errorf("Decompressed body does not match original")
+	}
+
+	// The second identical request is served from the pre-compressed cache
+	request("/large", "gzip")
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+
+	if got := request("/large", "gzip;q=0.5, br").Header().Get("Content-Encoding"); got != "br" {
+		t.Errorf("Expected brotli to be preferred, got %q", got)
+	}
+	if got := request("/large", "identity").Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Expected no encoding for identity, got %q", got)
+	}
+	if got := request("/small", "gzip").Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("Expected small bodies to stay uncompressed, got %q", got)
+	}
+
+	// Filling the cache with distinct URLs evicts old entries rather than
+	// refusing new ones
+	config.CacheMaxBytes = 4 << 10
+	router = gin.New()
+	router.Use(middleware.Compression(config))
+	router.GET("/large", middleware.Cacheable(), func(c *gin.Context) {
+		calls++
+		c.String(http.StatusOK, large)
+	})
+	for i := 0; i < 100; i++ {
+		request("/large?junk="+strconv.Itoa(i), "gzip")
+	}
+	calls = 0
+	request("/large?hot", "gzip")
+	request("/large?hot", "gzip")
+	if calls != 1 {
+		t.Errorf("Expected a full cache to keep new entries, handler ran %d times", calls)
+	}
+}
+
+func TestHTTPCaching(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	checksum := "dataset-v1"
+	loadTime := time.Date(2025, 7, 4, 10, 30, 0, 0, time.UTC)
+
+	router := gin.New()
+	router.Use(middleware.HTTPCache(middleware.HTTPCacheConfig{
+		Paths:        []string{"/api/v1/wards"},
+		Checksum:     func() string { return checksum },
+		LastModified: func() time.Time { return loadTime },
+		CacheControl: "public, max-age=300",
+	}))
+	router.GET("/api/v1/wards", func(c *gin.Context) { c.String(http.StatusOK, "wards") })
+	router.GET("/api/v1/wards/:code", func(c *gin.Context) { c.String(http.StatusNotFound, "missing") })
+
+	request := func(path string, headers map[string]string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request("/api/v1/wards?limit=10&search=ha", nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("Expected 200 with ETag, got %d %q", w.Code, etag)
+	}
+	if w.Header().Get("Cache-Control") != "public, max-age=300" || w.Header().Get("Last-Modified") == "" {
+		t.Errorf("Expected Cache-Control and Last-Modified headers")
+	}
+
+	// Parameter order does not change the ETag
+	if got := request("/api/v1/wards?search=ha&limit=10", nil).Header().Get("ETag"); got != etag {
+		t.Errorf("Expected same ETag for reordered params, got %q and %q", etag, got)
+	}
+	if got := request("/api/v1/wards?limit=20&search=ha", nil).Header().Get("ETag"); got == etag {
+		t.Errorf("Expected different ETag for different params")
+	}
+
+	for _, inm := range []string{etag, `W/` + etag, strings.TrimSuffix(etag, `"`) + `-gzip"`} {
+		if w := request("/api/v1/wards?limit=10&search=ha", map[string]string{"If-None-Match": inm}); w.Code != http.StatusNotModified {
+			t.Errorf("Expected 304 for If-None-Match %s, got %d", inm, w.Code)
+		}
+	}
+	if w := request("/api/v1/wards", map[string]string{"If-Modified-Since": loadTime.Format(http.TimeFormat)}); w.Code != http.StatusNotModified {
+		t.Errorf("Expected 304 for If-Modified-Since, got %d", w.Code)
+	}
+
+	// A reload with different data invalidates the ETag
+	checksum = "dataset-v2"
+	if w := request("/api/v1/wards?limit=10&search=ha", map[string]string{"If-None-Match": etag}); w.Code != http.StatusOK {
+		t.Errorf("Expected 200 after dataset change, got %d", w.Code)
+	}
+
+	// Errors are not cacheable
+	w = request("/api/v1/wards/999999", nil)
+	if w.Header().Get("ETag") != "" || w.Header().Get("Cache-Control") != "no-store" {
+		t.Errorf("Expected error response without ETag, got %q %q", w.Header().Get("ETag"), w.Header().Get("Cache-Control"))
+	}
+}
+
+func TestCursorPagination(t *testing.T) {
+	_, router := loadDataset(t)
+
+	request := func(path string) (*httptest.ResponseRecorder, models.PaginatedResponse) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(w, req)
+		var resp models.PaginatedResponse
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		return w, resp
+	}
+
+	// Walking the cursors visits every province exactly once
+	seen := map[string]bool{}
+	path, total := "/api/v1/provinces?limit=10", 0
+	for pages := 0; path != ""; pages++ {
+		if pages > 100 {
+			t.Fatalf("Cursor pagination did not terminate")
+		}
+		w, resp := request(path)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d: %s", w.Code, w.Body.String())
+		}
+		total = resp.Pagination.Total
+		for _, item := range resp.Data.([]interface{}) {
+			code := item.(map[string]interface{})["code"].(string)
+			if seen[code] {
+				t.Errorf("Province %s returned twice", code)
+			}
+			seen[code] = true
+		}
+		path = ""
+		if resp.Pagination.NextCursor != "" {
+			path = "/api/v1/provinces?limit=10&cursor=" + resp.Pagination.NextCursor
+		}
+	}
+	if len(seen) != total {
+		t.Errorf("Expected %d provinces, visited %d", total, len(seen))
+	}
+
+	if w, _ := request("/api/v1/provinces?cursor=not-a-cursor"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for malformed cursor, got %d", w.Code)
+	}
+	if w, _ := request("/api/v1/wards?offset=10000"); w.Code != http.StatusOK {
+		t.Errorf("Expected 200 for the largest offset, got %d", w.Code)
+	}
+	if w, _ := request("/api/v1/wards?offset=10001"); w.Code != http.StatusBadRequest ||
+		!strings.Contains(w.Body.String(), `"field":"offset"`) {
+		t.Errorf("Expected 400 for an offset past the maximum, got %d: %s", w.Code, w.Body.String())
+	}
+
+	stale := services.Cursor{Version: "0000000000000000", Sort: "name", Key: "A", Code: "01"}.Encode()
+	if w, _ := request("/api/v1/provinces?cursor=" + stale); w.Code != http.StatusConflict {
+		t.Errorf("Expected 409 for cursor from a previous dataset, got %d", w.Code)
+	}
+}
+
+func TestVietnameseSorting(t *testing.T) {
+	_, router := loadDataset(t)
+
+	names := func(sort string) []string {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/provinces?limit=100&sort="+sort, nil)
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected 200 for sort=%s, got %d", sort, w.Code)
+		}
+		var resp struct {
+			Data []models.Province `json:"data"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		result := make([]string, len(resp.Data))
+		for i, p := range resp.Data {
+			result[i] = p.Name
+		}
+		return result
+	}
+
+	index := func(list []string, name string) int {
+		for i, n := range list {
+			if n == name {
+				return i
+			}
+		}
+		t.Fatalf("Province %s not found", name)
+		return -1
+	}
+
+	// "Đ" is a letter of its own between "D" and "E", and unaccented
+	// vowels sort before tone-marked ones
+	ascending := names("name")
+	for _, pair := range [][2]string{
+		{"Cần Thơ", "Đà Nẵng"},
+		{"Đồng Tháp", "Gia Lai"},
+		{"Hà Nội", "Hải Phòng"},
+		{"Hồ Chí Minh", "Huế"},
+		{"Huế", "Hưng Yên"},
+	} {
+		if index(ascending, pair[0]) > index(ascending, pair[1]) {
+			t.Errorf("Expected %s before %s in %v", pair[0], pair[1], ascending)
+		}
+	}
+
+	descending := names("-name")
+	for i := range ascending {
+		if ascending[i] != descending[len(descending)-1-i] {
+			t.Fatalf("Expected -name to reverse name order, got %v", descending)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/provinces?sort=population", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown sort field, got %d", w.Code)
+	}
+}
+
+func TestFieldProjection(t *testing.T) {
+	_, router := loadDataset(t)
+
+	request := func(path string) (*httptest.ResponseRecorder, []map[string]interface{}) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(w, req)
+		var resp struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		return w, resp.Data
+	}
+
+	w, wards := request("/api/v1/provinces/11/wards?limit=5&fields=code,name_with_type&include=province")
+	if w.Code != http.StatusOK || len(wards) == 0 {
+		t.Fatalf("Expected 200 with wards, got %d: %s", w.Code, w.Body.String())
+	}
+	for _, ward := range wards {
+		if len(ward) != 3 || ward["code"] == nil || ward["name_with_type"] == nil {
+			t.Errorf("Expected code, name_with_type and province only, got %v", ward)
+		}
+		province, _ := ward["province"].(map[string]interface{})
+		if province["code"] != "11" {
+			t.Errorf("Expected embedded province 11, got %v", ward["province"])
+		}
+	}
+
+	if w, _ := request("/api/v1/provinces/11/wards?fields=code,population"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown field, got %d", w.Code)
+	}
+	if w, _ := request("/api/v1/provinces/11/wards?include=districts"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unsupported include, got %d", w.Code)
+	}
+
+	code := wards[0]["code"].(string)
+	w = httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/wards/"+code+"?fields=name", nil)
+	router.ServeHTTP(w, req)
+	var detail struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &detail)
+	if len(detail.Data) != 2 || detail.Data["name"] == nil || detail.Data["province"] == nil {
+		t.Errorf("Expected ward detail with name and province, got %v", detail.Data)
+	}
+}
+
+func TestExportEndpoint(t *testing.T) {
+	dataService, router := loadDataset(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/export?format=csv&province_code=11&bom=true", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "text/csv") {
+		t.Fatalf("Expected CSV response, got %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+
+	body := strings.TrimPrefix(w.Body.String(), "\ufeff")
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	if !strings.HasSuffix(lines[0], "province_name,province_type,province_name_with_type") {
+		t.Errorf("Expected joined ward-with-province header, got %q", lines[0])
+	}
+	wards := dataService.GetWardsByProvince(context.Background(), "11")
+	if len(lines)-1 != len(wards) {
+		t.Errorf("Expected %d ward rows, got %d", len(wards), len(lines)-1)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/export?format=pdf", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unsupported format, got %d", w.Code)
+	}
+}
+
+func TestWardStream(t *testing.T) {
+	dataService, router := loadDataset(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/wards/stream", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "application/x-ndjson") {
+		t.Fatalf("Expected NDJSON response, got %d %q", w.Code, w.Header().Get("Content-Type"))
+	}
+	if !w.Flushed {
+		t.Errorf("Expected the stream to be flushed")
+	}
+
+	lines := strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")
+	var trailer models.StreamTrailer
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &trailer); err != nil {
+		t.Fatalf("Invalid trailer record: %v", err)
+	}
+	total := len(dataService.GetAllWards())
+	if trailer.Trailer.Count != total || len(lines)-1 != total {
+		t.Errorf("Expected %d records, trailer says %d, stream has %d", total, trailer.Trailer.Count, len(lines)-1)
+	}
+	if trailer.Trailer.Version != dataService.GetChecksum() {
+		t.Errorf("Expected trailer version %s, got %s", dataService.GetChecksum(), trailer.Trailer.Version)
+	}
+
+	var ward models.Ward
+	if err := json.Unmarshal([]byte(lines[0]), &ward); err != nil || ward.Code == "" {
+		t.Errorf("Expected a ward record on the first line, got %q", lines[0])
+	}
+}
+
+func TestTreeEndpoint(t *testing.T) {
+	dataService, router := loadDataset(t)
+
+	request := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request("/api/v1/tree")
+	var nested struct {
+		Version string                   `json:"version"`
+		Data    []map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &nested); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("Expected nested tree, got %d: %v", w.Code, err)
+	}
+	wardCount := 0
+	for _, province := range nested.Data {
+		wardCount += len(province["wards"].([]interface{}))
+	}
+	if len(nested.Data) != len(dataService.GetAllProvinces()) || wardCount != len(dataService.GetAllWards()) {
+		t.Errorf("Expected every province and ward in the tree, got %d provinces and %d wards", len(nested.Data), wardCount)
+	}
+	if nested.Version != dataService.GetChecksum() {
+		t.Errorf("Expected tree version to be the dataset checksum")
+	}
+
+	w = request("/api/v1/tree?format=compact&fields=code,name")
+	var compact models.CompactTreeResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &compact); err != nil || w.Code != http.StatusOK {
+		t.Fatalf("Expected compact tree, got %d: %v", w.Code, err)
+	}
+	if strings.Join(compact.Fields.Ward, ",") != "code,name" {
+		t.Errorf("Expected ward fields code,name, got %v", compact.Fields.Ward)
+	}
+	first := compact.Data[0].([]interface{})
+	if len(first) != 3 {
+		t.Errorf("Expected code, name and wards in compact province, got %v", first)
+	}
+
+	if w := request("/api/v1/tree?format=xml"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for unknown format, got %d", w.Code)
+	}
+}
+
+func TestGraphQLEndpoint(t *testing.T) {
+	dataService, _ := loadDataset(t)
+	schema, err := gql.NewSchema(dataService)
+	if err != nil {
+		t.Fatalf("Failed to build schema: %v", err)
+	}
+
+	newRouter := func(graphiQL bool) *gin.Engine {
+		router := gin.New()
+		handler := gql.Handler(gql.Config{Schema: &schema, Limits: gql.DefaultLimits(), GraphiQL: graphiQL})
+		router.GET("/graphql", handler)
+		router.POST("/graphql", handler)
+		return router
+	}
+	router := newRouter(false)
+
+	query := func(q string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]string{"query": q})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		var resp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp
+	}
+
+	code, resp := query(`{ province(code: "11") { nameWithType wards(limit: 2) { total items { code province { code } } } } }`)
+	if code != http.StatusOK || resp["errors"] != nil {
+		t.Fatalf("Expected successful query, got %d %v", code, resp)
+	}
+	province := resp["data"].(map[string]interface{})["province"].(map[string]interface{})
+	wards := province["wards"].(map[string]interface{})
+	if len(wards["items"].([]interface{})) != 2 || wards["total"].(float64) < 2 {
+		t.Errorf("Expected two wards of a larger total, got %v", wards)
+	}
+
+	if code, resp := query(`{ provinces(limit: 1000) { items { wards(limit: 1000) { items { code } } } } }`); code != http.StatusBadRequest {
+		t.Errorf("Expected complexity limit to reject query, got %d %v", code, resp)
+	}
+	deep := `{ ward(code: "1") { province { wards { items { province { wards { items { province { code } } } } } } } } }`
+	if code, resp := query(deep); code != http.StatusBadRequest {
+		t.Errorf("Expected depth limit to reject query, got %d %v", code, resp)
+	}
+
+	// The playground is only served when enabled
+	for _, enabled := range []bool{false, true} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/graphql", nil)
+		req.Header.Set("Accept", "text/html")
+		newRouter(enabled).ServeHTTP(w, req)
+		if served := strings.Contains(w.Body.String(), "GraphiQL"); served != enabled {
+			t.Errorf("GraphiQL enabled=%v, served=%v", enabled, served)
+		}
+	}
+}
+
+func TestGRPCServer(t *testing.T) {
+	dataService, _ := loadDataset(t)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpcserver.New(dataService)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := adminv1.NewAdminServiceClient(conn)
+
+	t.Run("health", func(t *testing.T) {
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil || resp.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Fatalf("Expected SERVING, got %v (%v)", resp.GetStatus(), err)
+		}
+	})
+
+	t.Run("get province", func(t *testing.T) {
+		province, err := client.GetProvince(ctx, &adminv1.GetProvinceRequest{Code: "11"})
+		if err != nil || province.Code != "11" {
+			t.Fatalf("Expected province 11, got %v (%v)", province, err)
+		}
+
+		_, err = client.GetProvince(ctx, &adminv1.GetProvinceRequest{Code: "00"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("list wards with cursor", func(t *testing.T) {
+		first, err := client.ListWards(ctx, &adminv1.ListWardsRequest{
+			ProvinceCode: "11",
+			Page:         &adminv1.PageRequest{Limit: 5, Sort: "code"},
+		})
+		if err != nil {
+			t.Fatalf("ListWards failed: %v", err)
+		}
+		if len(first.Wards) != 5 || first.Page.NextCursor == "" {
+			t.Fatalf("Expected 5 wards and a cursor, got %d and %q", len(first.Wards), first.Page.NextCursor)
+		}
+
+		next, err := client.ListWards(ctx, &adminv1.ListWardsRequest{
+			ProvinceCode: "11",
+			Page:         &adminv1.PageRequest{Limit: 5, Sort: "code", Cursor: first.Page.NextCursor},
+		})
+		if err != nil {
+			t.Fatalf("ListWards failed: %v", err)
+		}
+		if len(next.Wards) == 0 || next.Wards[0].Code <= first.Wards[4].Code {
+			t.Errorf("Expected the next page to follow %s", first.Wards[4].Code)
+		}
+
+		_, err = client.ListWards(ctx, &adminv1.ListWardsRequest{Page: &adminv1.PageRequest{Sort: "population"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for an unknown sort, got %v", err)
+		}
+	})
+
+	t.Run("validate address", func(t *testing.T) {
+		wards := dataService.GetWardsByProvince(ctx, "11")
+		if len(wards) == 0 {
+			t.Fatal("Expected wards in province 11")
+		}
+		resp, err := client.ValidateAddress(ctx, &adminv1.ValidateAddressRequest{ProvinceCode: "11", WardCode: wards[0].Code})
+		if err != nil || !resp.Valid || resp.Ward.GetCode() != wards[0].Code {
+			t.Errorf("Expected a valid address, got %v (%v)", resp, err)
+		}
+
+		resp, err = client.ValidateAddress(ctx, &adminv1.ValidateAddressRequest{ProvinceName: "HN", WardName: wards[0].NameWithType})
+		if err != nil || !resp.Valid || resp.Ward.GetCode() != wards[0].Code || resp.Province.GetMatchedAlias() != "HN" {
+			t.Errorf("Expected the address to be resolved by name, got %v (%v)", resp, err)
+		}
+		if _, err := client.ValidateAddress(ctx, &adminv1.ValidateAddressRequest{ProvinceCode: "11"}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument without a ward, got %v", err)
+		}
+	})
+
+	t.Run("export wards", func(t *testing.T) {
+		stream, err := client.ExportWards(ctx, &adminv1.ExportWardsRequest{ProvinceCode: "11"})
+		if err != nil {
+			t.Fatalf("ExportWards failed: %v", err)
+		}
+		count := 0
+		for {
+			ward, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Stream failed after %d wards: %v", count, err)
+			}
+			if ward.ParentCode != "11" {
+				t.Fatalf("Unexpected ward from province %s", ward.ParentCode)
+			}
+			count++
+		}
+		if want := len(dataService.GetWardsByProvince(ctx, "11")); count != want {
+			t.Errorf("Expected %d wards, streamed %d", want, count)
+		}
+	})
+
+	t.Run("export by region", func(t *testing.T) {
+		stream, err := client.ExportProvinces(ctx, &adminv1.ExportProvincesRequest{Region: "tay-nguyen"})
+		if err != nil {
+			t.Fatalf("ExportProvinces failed: %v", err)
+		}
+		count := 0
+		for {
+			province, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Stream failed after %d provinces: %v", count, err)
+			}
+			if province.EconomicRegion != "tay-nguyen" {
+				t.Fatalf("Unexpected province of %s", province.EconomicRegion)
+			}
+			count++
+		}
+		if count == 0 {
+			t.Error("Expected provinces of the Central Highlands")
+		}
+
+		wards, err := client.ExportWards(ctx, &adminv1.ExportWardsRequest{Region: "atlantis"})
+		if err == nil {
+			_, err = wards.Recv()
+		}
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument for an unknown region, got %v", err)
+		}
+	})
+}
+
+func TestOpenAPISpecCoversRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	dataService := services.NewDataService("./testdata")
+	schema, err := gql.NewSchema(dataService)
+	if err != nil {
+		t.Fatalf("Failed to build schema: %v", err)
+	}
+	config := loadConfig()
+	config.GraphQL.Schema = &schema
+	config.AdminToken = "test-token"
+	router := setupRouter(handlers.NewAPIHandler(dataService, "test"), config)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/openapi.json", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("Expected OpenAPI %s, got %q", openapi.Version, doc.OpenAPI)
+	}
+
+	// Every registered route must be documented, and nothing else
+	routes := map[string]bool{}
+	for _, route := range router.Routes() {
+		path := ginPathParam.ReplaceAllString(route.Path, "{$1}")
+		routes[route.Method+" "+path] = true
+		if !doc.Has(route.Method, path) {
+			t.Errorf("Route %s %s is missing from the OpenAPI spec", route.Method, path)
+		}
+	}
+	for path, item := range doc.Paths {
+		for method := range item {
+			if !routes[strings.ToUpper(method)+" "+path] {
+				t.Errorf("OpenAPI spec documents %s %s, which is not registered", method, path)
+			}
+		}
+	}
+
+	for _, name := range []string{"PaginatedResponse", "SearchResponse", "ValidationResponse", "HealthResponse", "Province", "Ward"} {
+		if doc.Components.Schemas[name] == nil {
+			t.Errorf("Expected a %s schema", name)
+		}
+	}
+
+	// The type filters list the type slugs of the dataset
+	dataset, _ := loadDataset(t)
+	for parameter, types := range map[string][]string{
+		"provinceType": dataset.GetProvinceTypes(),
+		"wardType":     dataset.GetWardTypes(),
+	} {
+		var documented []string
+		for _, value := range doc.Components.Parameters[parameter].Schema.Enum {
+			documented = append(documented, value.(string))
+		}
+		sort.Strings(documented)
+		sort.Strings(types)
+		if strings.Join(documented, ",") != strings.Join(types, ",") {
+			t.Errorf("Expected %s to list %v, got %v", parameter, types, documented)
+		}
+	}
+}
+
+// ginPathParam matches :name and *name segments of gin routes
+var ginPathParam = regexp.MustCompile(`[:*]([A-Za-z_]+)`)
+
+func TestErrorEnvelope(t *testing.T) {
+	_, router := loadDataset(t)
+	router.GET("/panic", func(c *gin.Context) { panic("boom") })
+
+	request := func(method, url, body string) (int, models.ErrorResponse) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Request-ID", "envelope-test")
+		router.ServeHTTP(w, req)
+
+		var response models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("%s %s: failed to parse response: %v", method, url, err)
+		}
+		return w.Code, response
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		status int
+		code   models.ErrorCode
+		fields []string
+	}{
+		{"invalid limit", "GET", "/api/v1/provinces?limit=abc", "", 400, models.ErrCodeInvalidParameter, []string{"limit"}},
+		{"limit out of range", "GET", "/api/v1/wards?limit=5000&offset=-1", "", 400, models.ErrCodeInvalidParameter, []string{"limit", "offset"}},
+		{"unknown entity", "GET", "/api/v1/search?q=ha&entity=district", "", 400, models.ErrCodeInvalidParameter, []string{"entity"}},
+		{"search limit", "GET", "/api/v1/search?q=ha&limit=101", "", 400, models.ErrCodeInvalidParameter, []string{"limit"}},
+		{"unknown sort", "GET", "/api/v1/wards?sort=population", "", 400, models.ErrCodeInvalidParameter, []string{"sort"}},
+		{"invalid bom", "GET", "/api/v1/export?bom=maybe", "", 400, models.ErrCodeInvalidParameter, []string{"bom"}},
+		{"missing body field", "POST", "/api/v1/address/validate", `{"province_code":"11"}`, 400, models.ErrCodeInvalidBody, []string{"ward_code"}},
+		{"wrong body type", "POST", "/api/v1/address/validate", `{"province_code":11,"ward_code":"1"}`, 400, models.ErrCodeInvalidBody, []string{"province_code"}},
+		{"unknown province", "GET", "/api/v1/provinces/00", "", 404, models.ErrCodeNotFound, nil},
+		{"unknown route", "GET", "/api/v1/districts", "", 404, models.ErrCodeRouteNotFound, nil},
+		{"panic", "GET", "/panic", "", 500, models.ErrCodeInternal, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, response := request(tt.method, tt.url, tt.body)
+			if status != tt.status || response.Success || response.Error.Code != tt.code {
+				t.Fatalf("Expected %d %s, got %d %+v", tt.status, tt.code, status, response)
+			}
+			if response.Error.Message == "" || response.Error.RequestID != "envelope-test" {
+				t.Errorf("Expected a message and the request ID, got %+v", response.Error)
+			}
+			if len(response.Error.Details) != len(tt.fields) {
+				t.Fatalf("Expected details for %v, got %+v", tt.fields, response.Error.Details)
+			}
+			for i, field := range tt.fields {
+				if response.Error.Details[i].Field != field || response.Error.Details[i].Message == "" {
+					t.Errorf("Expected a violation of %s, got %+v", field, response.Error.Details[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLocalizedMessages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	catalog, err := i18n.Load("./locales", "en")
+	if err != nil {
+		t.Fatalf("Failed to load catalogs: %v", err)
+	}
+	previous := i18n.Default()
+	i18n.SetDefault(catalog)
+	t.Cleanup(func() { i18n.SetDefault(previous) })
+
+	_, router := loadDataset(t)
+
+	request := func(url, acceptLanguage string) (*httptest.ResponseRecorder, models.ErrorResponse) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		router.ServeHTTP(w, req)
+
+		var response models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return w, response
+	}
+
+	tests := []struct {
+		name, url, acceptLanguage, lang, message string
+	}{
+		{"default", "/api/v1/provinces/00", "", "en", "Province not found"},
+		{"accept-language", "/api/v1/provinces/00", "vi-VN,vi;q=0.9,en;q=0.8", "vi", "Không tìm thấy tỉnh/thành phố"},
+		{"preference order", "/api/v1/provinces/00", "fr;q=1, en;q=0.5, vi;q=0.7", "vi", "Không tìm thấy tỉnh/thành phố"},
+		{"query overrides header", "/api/v1/provinces/00?lang=en", "vi", "en", "Province not found"},
+		{"placeholders", "/api/v1/wards?limit=0&lang=vi", "", "vi", "limit phải là số nguyên từ 1 đến 1000"},
+		{"route not found", "/api/v1/districts?lang=vi", "", "vi", "Không tìm thấy endpoint"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, response := request(tt.url, tt.acceptLanguage)
+			if got := w.Header().Get("Content-Language"); got != tt.lang {
+				t.Errorf("Expected Content-Language %s, got %q", tt.lang, got)
+			}
+			if response.Error.Message != tt.message {
+				t.Errorf("Expected message %q, got %q", tt.message, response.Error.Message)
+			}
+		})
+	}
+
+	w, response := request("/api/v1/provinces?lang=fr", "")
+	if w.Code != http.StatusBadRequest || response.Error.Code != models.ErrCodeInvalidParameter ||
+		len(response.Error.Details) != 1 || response.Error.Details[0].Field != "lang" {
+		t.Errorf("Expected 400 for an unsupported lang, got %d %+v", w.Code, response)
+	}
+}
+
+func TestEnglishNames(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	catalog, err := i18n.Load("./locales", "en")
+	if err != nil {
+		t.Fatalf("Failed to load catalogs: %v", err)
+	}
+	previous := i18n.Default()
+	i18n.SetDefault(catalog)
+	t.Cleanup(func() { i18n.SetDefault(previous) })
+
+	dataService, router := loadDataset(t)
+
+	ctx := context.Background()
+	province, err := dataService.GetProvince(ctx, "12")
+	if err != nil {
+		t.Fatalf("Failed to get province 12: %v", err)
+	}
+	if province.NameASCII != "Ho Chi Minh" || province.NameWithTypeEn != "Ho Chi Minh City" {
+		t.Errorf("Unexpected generated names %+v", province)
+	}
+	if hanoi, _ := dataService.GetProvince(ctx, "11"); hanoi == nil || hanoi.NameEn != "Hanoi" || hanoi.NameASCII != "Ha Noi" {
+		t.Errorf("Expected the data file to override the English name of Hà Nội, got %+v", hanoi)
+	}
+	if got := models.ASCIIName("Đắk Lắk"); got != "Dak Lak" {
+		t.Errorf("Expected diacritics to be removed, got %q", got)
+	}
+
+	request := func(url string) map[string]interface{} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d: %s", url, w.Code, w.Body.String())
+		}
+		var response map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return response
+	}
+
+	ward := request("/api/v1/wards/7948?lang=en")["data"].(map[string]interface{})
+	for field, want := range map[string]string{
+		"name":              "Ben Thanh",
+		"name_with_type":    "Ben Thanh Ward",
+		"path_with_type":    "Ben Thanh Ward, Ho Chi Minh City",
+		"name_with_type_en": "Ben Thanh Ward",
+	} {
+		if ward[field] != want {
+			t.Errorf("Expected %s %q with lang=en, got %v", field, want, ward[field])
+		}
+	}
+	if embedded := ward["province"].(map[string]interface{}); embedded["name_with_type"] != "Ho Chi Minh City" {
+		t.Errorf("Expected the embedded province in English, got %v", embedded)
+	}
+
+	ward = request("/api/v1/wards/7948")["data"].(map[string]interface{})
+	if ward["name_with_type"] != "Phường Bến Thành" || ward["name_with_type_en"] != "Ben Thanh Ward" {
+		t.Errorf("Expected Vietnamese names by default, got %v", ward)
+	}
+
+	search := request("/api/v1/provinces?search=ho+chi+minh+city")
+	if data := search["data"].([]interface{}); len(data) != 1 || data[0].(map[string]interface{})["code"] != "12" {
+		t.Errorf("Expected English names to be searchable, got %v", search["data"])
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/api/v1/address/validate?lang=en", strings.NewReader(`{"province_code":"12","ward_code":"7948"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	var validation models.ValidationResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &validation); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if !validation.Valid || validation.Data == nil || validation.Data.NameWithType != "Ben Thanh Ward" ||
+		validation.Data.PathWithType != "Ben Thanh Ward, Ho Chi Minh City" ||
+		validation.Province == nil || validation.Province.NameWithType != "Ho Chi Minh City" {
+		t.Errorf("Expected the validated address in English, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestAliases(t *testing.T) {
+	// Aliases are saved to the data directory, so the test works on a copy
+	dataService, defaultRouter := loadPatchedDataset(t, []string{"province.json", "ward.json", "aliases.json"}, nil)
+	config := loadConfig()
+	config.AdminToken = "alias-token"
+	router := setupRouter(handlers.NewAPIHandler(dataService, "test"), config)
+
+	request := func(method, url, body string) (*httptest.ResponseRecorder, map[string]interface{}) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer alias-token")
+		router.ServeHTTP(w, req)
+		var response map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return w, response
+	}
+	matches := func(url string) []interface{} {
+		w, response := request("GET", url, "")
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d: %s", url, w.Code, w.Body.String())
+		}
+		return response["data"].([]interface{})
+	}
+
+	for alias, want := range map[string]string{"TP.HCM": "TP.HCM", "tphcm": "TP.HCM", "sai gon": "Sài Gòn", "BR-VT": "BR-VT"} {
+		data := matches("/api/v1/provinces?search=" + url.QueryEscape(alias))
+		if len(data) != 1 || data[0].(map[string]interface{})["code"] != "12" ||
+			data[0].(map[string]interface{})["matched_alias"] != want {
+			t.Errorf("Expected %q to match Hồ Chí Minh through %q, got %v", alias, want, data)
+		}
+	}
+	if data := matches("/api/v1/provinces?search=" + url.QueryEscape("Hồ Chí Minh")); len(data) != 1 ||
+		data[0].(map[string]interface{})["matched_alias"] != nil {
+		t.Errorf("Expected a name match without matched_alias, got %v", data)
+	}
+
+	// Editing aliases requires the admin token, and is not mounted without one
+	for _, tt := range []struct {
+		router        *gin.Engine
+		authorization string
+		want          int
+	}{
+		{router, "", http.StatusUnauthorized},
+		{router, "Bearer wrong-token", http.StatusUnauthorized},
+		{defaultRouter, "", http.StatusNotFound},
+	} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/api/v1/admin/aliases/wards/7948", strings.NewReader(`{"aliases":["X"]}`))
+		req.Header.Set("Content-Type", "application/json")
+		if tt.authorization != "" {
+			req.Header.Set("Authorization", tt.authorization)
+		}
+		tt.router.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("Expected %d for authorization %q, got %d", tt.want, tt.authorization, w.Code)
+		}
+	}
+	for _, alias := range dataService.GetAliases().Wards["7948"] {
+		if alias == "X" {
+			t.Error("Expected rejected requests not to save aliases")
+		}
+	}
+
+	w, response := request("PUT", "/api/v1/admin/aliases/wards/7948", `{"aliases":["Chợ Bến Thành"," chợ bến thành ",""]}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected 200 setting aliases, got %d: %s", w.Code, w.Body.String())
+	}
+	if saved := response["data"].(map[string]interface{})["aliases"].([]interface{}); len(saved) != 1 {
+		t.Errorf("Expected duplicate and empty aliases to be dropped, got %v", saved)
+	}
+	if data := matches("/api/v1/wards?search=cho+ben+thanh"); len(data) != 1 ||
+		data[0].(map[string]interface{})["matched_alias"] != "Chợ Bến Thành" {
+		t.Errorf("Expected the new alias to match ward 7948, got %v", data)
+	}
+	// The alias was saved to aliases.json, so it survives reloading the files
+	if w, _ := request("POST", "/api/v1/admin/reload", ""); w.Code != http.StatusOK {
+		t.Fatalf("Expected 200 reloading, got %d", w.Code)
+	}
+	if data := matches("/api/v1/wards?search=cho+ben+thanh"); len(data) != 1 {
+		t.Errorf("Expected the alias to be saved, got %v", data)
+	}
+
+	// Validation resolves names and aliases, and ward names within the province
+	validations := []struct {
+		body                     string
+		valid                    bool
+		ward, province           string
+		wardAlias, provinceAlias interface{}
+	}{
+		{`{"province_name":"tp hcm","ward_name":"cho ben thanh"}`, true, "7948", "12", "Chợ Bến Thành", "TP.HCM"},
+		{`{"province_name":"Hà Nội","ward_name":"minh chau"}`, true, "267", "11", nil, nil},
+		{`{"province_code":"33","ward_name":"Xã Minh Châu"}`, true, "7201", "33", nil, nil},
+		{`{"province_name":"Ho Chi Minh City","ward_code":"7948"}`, true, "7948", "12", nil, nil},
+		{`{"province_name":"Hồ Chí Minh","ward_name":"Minh Châu"}`, false, "", "", nil, nil},
+		{`{"province_name":"Atlantis","ward_name":"Bến Thành"}`, false, "", "", nil, nil},
+	}
+	for _, tt := range validations {
+		w, response := request("POST", "/api/v1/address/validate", tt.body)
+		if w.Code != http.StatusOK || response["valid"] != tt.valid {
+			t.Errorf("%s: expected valid=%v, got %d %v", tt.body, tt.valid, w.Code, response)
+			continue
+		}
+		if !tt.valid {
+			continue
+		}
+		ward, province := response["data"].(map[string]interface{}), response["province"].(map[string]interface{})
+		if ward["code"] != tt.ward || ward["matched_alias"] != tt.wardAlias ||
+			province["code"] != tt.province || province["matched_alias"] != tt.provinceAlias {
+			t.Errorf("%s: expected ward %s (%v) of %s (%v), got %v", tt.body, tt.ward, tt.wardAlias, tt.province, tt.provinceAlias, response)
+		}
+	}
+	w, response = request("POST", "/api/v1/address/validate", `{"ward_name":"Bến Thành"}`)
+	if details, _ := response["error"].(map[string]interface{})["details"].([]interface{}); w.Code != http.StatusBadRequest ||
+		len(details) != 1 || details[0].(map[string]interface{})["field"] != "province_code" {
+		t.Errorf("Expected 400 without a province code or name, got %d %v", w.Code, response)
+	}
+
+	if w, _ := request("DELETE", "/api/v1/admin/aliases/wards/7948", ""); w.Code != http.StatusOK {
+		t.Errorf("Expected 200 removing aliases, got %d", w.Code)
+	}
+	if data := matches("/api/v1/wards?search=cho+ben+thanh"); len(data) != 0 {
+		t.Errorf("Expected the removed alias not to match, got %v", data)
+	}
+
+	if w, _ := request("PUT", "/api/v1/admin/aliases/provinces/00", `{"aliases":["X"]}`); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown province, got %d", w.Code)
+	}
+	if w, _ := request("PUT", "/api/v1/admin/aliases/districts/12", `{"aliases":["X"]}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an unknown kind, got %d", w.Code)
+	}
+}
+
+func TestIMESearch(t *testing.T) {
+	_, router := loadDataset(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/search?entity=province&ime=telex&q="+url.QueryEscape("Hoof Chis Minh"), nil)
+	router.ServeHTTP(w, req)
+	var response models.SearchResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if w.Code != http.StatusOK || response.ConvertedQuery != "Hồ Chí Minh" || response.Query != "Hoof Chis Minh" ||
+		len(response.Data.Provinces) != 1 || response.Data.Provinces[0].Code != "12" {
+		t.Errorf("Expected the Telex query to find Hồ Chí Minh, got %d %+v", w.Code, response)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/provinces?ime=auto&search="+url.QueryEscape("D9a2 Na8ng4"), nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"code":"13"`) {
+		t.Errorf("Expected the VNI search to find Đà Nẵng, got %d %s", w.Code, w.Body.String())
+	}
+
+	// Without ime the keystrokes are searched as they are
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/provinces?search="+url.QueryEscape("Hoof Chis Minh"), nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"total":0`) {
+		t.Errorf("Expected no match without ime, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/provinces?ime=viqr&search=x", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an unknown input method, got %d", w.Code)
+	}
+}
+
+func TestProvinceMetadata(t *testing.T) {
+	dataService, router := loadDataset(t)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/provinces/12", nil)
+	router.ServeHTTP(w, req)
+	var detail struct {
+		Data models.Province `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &detail); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	province := detail.Data
+	if province.Region != "mien-nam" || province.EconomicRegion != "dong-nam-bo" ||
+		len(province.PhoneCodes) == 0 || province.PhoneCodes[0] != "28" || len(province.PlateCodes) == 0 {
+		t.Errorf("Expected the metadata of Hồ Chí Minh, got %+v", province)
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/provinces/regions", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"mien-trung"`) || !strings.Contains(w.Body.String(), `"tay-nguyen"`) {
+		t.Errorf("Expected the regions, got %d %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/provinces?region=mien-bac&limit=100", nil)
+	router.ServeHTTP(w, req)
+	var provinces struct {
+		Data []models.Province `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &provinces); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(provinces.Data) == 0 {
+		t.Error("Expected northern provinces")
+	}
+	for _, p := range provinces.Data {
+		if p.Region != "mien-bac" {
+			t.Errorf("Expected only northern provinces, got %s in %s", p.Name, p.Region)
+		}
+	}
+
+	// Wards are filtered by the region of their province
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/wards?region=tay-nguyen&limit=1000", nil)
+	router.ServeHTTP(w, req)
+	var wards struct {
+		Data []models.Ward `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &wards); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(wards.Data) == 0 {
+		t.Error("Expected wards of the Central Highlands")
+	}
+	for _, ward := range wards.Data {
+		if p, err := dataService.GetProvince(context.Background(), ward.ParentCode); err != nil || p.EconomicRegion != "tay-nguyen" {
+			t.Errorf("Expected only wards of the Central Highlands, got %s of %s", ward.Name, ward.ParentCode)
+		}
+	}
+
+	// Streams and exports take the same filter
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/wards/stream?region=tay-nguyen", nil)
+	router.ServeHTTP(w, req)
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	if w.Code != http.StatusOK || len(lines) != len(wards.Data)+1 {
+		t.Errorf("Expected %d streamed wards and a trailer, got %d %d lines", len(wards.Data), w.Code, len(lines))
+	}
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/export?dataset=provinces&region=mien-bac", nil)
+	router.ServeHTTP(w, req)
+	rows, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil || len(rows) != len(provinces.Data)+1 {
+		t.Fatalf("Expected %d exported provinces and a header, got %d rows (%v)", len(provinces.Data), len(rows), err)
+	}
+	for _, row := range rows[1:] {
+		if row[8] != "mien-bac" {
+			t.Errorf("Expected only northern provinces, got %v", row)
+		}
+	}
+
+	for _, url := range []string{"/api/v1/wards?region=atlantis", "/api/v1/wards/stream?region=atlantis", "/api/v1/export?region=atlantis"} {
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("GET", url, nil)
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400 for an unknown region, got %d", url, w.Code)
+		}
+	}
+}
+
+func TestPostalCodes(t *testing.T) {
+	// Ward postal codes are added to a copy of the data
+	_, router := loadPatchedDataset(t, []string{"province.json", "ward.json", "postal.json"}, func(name string, data []byte) []byte {
+		if name != "postal.json" {
+			return data
+		}
+		var postal models.PostalCodes
+		if err := json.Unmarshal(data, &postal); err != nil {
+			t.Fatal(err)
+		}
+		postal.Wards = map[string]string{"7948": "71009", "31755": "70000"}
+		data, _ = json.Marshal(postal)
+		return data
+	})
+
+	request := func(method, url, body string) (*httptest.ResponseRecorder, map[string]interface{}) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		var response map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return w, response
+	}
+
+	w, response := request("GET", "/api/v1/postal/10000", "")
+	data, _ := response["data"].(map[string]interface{})
+	province, _ := data["province"].(map[string]interface{})
+	if w.Code != http.StatusOK || province["code"] != "11" || len(data["wards"].([]interface{})) != 0 {
+		t.Errorf("Expected 10000 to resolve to Hà Nội, got %d %v", w.Code, response)
+	}
+
+	w, response = request("GET", "/api/v1/postal/71009", "")
+	data, _ = response["data"].(map[string]interface{})
+	wards, _ := data["wards"].([]interface{})
+	if w.Code != http.StatusOK || len(wards) != 1 || wards[0].(map[string]interface{})["code"] != "7948" {
+		t.Errorf("Expected 71009 to resolve to ward 7948, got %d %v", w.Code, response)
+	}
+
+	// A ward code outside the ward's province is ignored when loading
+	w, response = request("GET", "/api/v1/wards/31755/postal", "")
+	data, _ = response["data"].(map[string]interface{})
+	if w.Code != http.StatusOK || data["postal_code"] != nil || len(data["province_postal_codes"].([]interface{})) == 0 {
+		t.Errorf("Expected only the province codes of ward 31755, got %d %v", w.Code, response)
+	}
+
+	w, response = request("GET", "/api/v1/wards/7948/postal", "")
+	data, _ = response["data"].(map[string]interface{})
+	if w.Code != http.StatusOK || data["postal_code"] != "71009" {
+		t.Errorf("Expected the postal code of ward 7948, got %d %v", w.Code, response)
+	}
+
+	if w, _ := request("GET", "/api/v1/postal/1000", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a malformed postal code, got %d", w.Code)
+	}
+	if w, _ := request("GET", "/api/v1/postal/99000", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown postal code, got %d", w.Code)
+	}
+	if w, _ := request("GET", "/api/v1/wards/00000/postal", ""); w.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown ward, got %d", w.Code)
+	}
+
+	validations := []struct {
+		body        string
+		valid       bool
+		postalValid interface{}
+	}{
+		{`{"province_code":"12","ward_code":"7948","postal_code":"71009"}`, true, true},
+		{`{"province_code":"12","ward_code":"7948","postal_code":"70000"}`, false, false},
+		{`{"province_code":"11","ward_code":"31755","postal_code":"10000"}`, true, true},
+		{`{"province_code":"11","ward_code":"31755","postal_code":"70000"}`, false, false},
+		{`{"province_code":"11","ward_code":"31755"}`, true, nil},
+	}
+	for _, tt := range validations {
+		w, response := request("POST", "/api/v1/address/validate", tt.body)
+		if w.Code != http.StatusOK || response["valid"] != tt.valid || response["postal_code_valid"] != tt.postalValid {
+			t.Errorf("Validating %s: got %d %v", tt.body, w.Code, response)
+		}
+	}
+	if w, _ := request("POST", "/api/v1/address/validate", `{"province_code":"11","ward_code":"31755","postal_code":"abc"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a malformed postal code, got %d", w.Code)
+	}
+}
+
+func TestNearestWards(t *testing.T) {
+	// The committed dataset has no ward centroids, so the search is unavailable
+	_, router := loadDataset(t)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/geo/nearest?lat=10.78&lng=106.70", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503 without ward centroids, got %d", w.Code)
+	}
+
+	// Ward centroids are added to a copy of the data
+	dataService, router := loadPatchedDataset(t, []string{"province.json", "ward.json", "centroids.json"}, func(name string, data []byte) []byte {
+		if name != "centroids.json" {
+			return data
+		}
+		var centroids models.Centroids
+		if err := json.Unmarshal(data, &centroids); err != nil {
+			t.Fatal(err)
+		}
+		centroids.Wards = map[string]models.Coordinates{
+			"31755": {Lat: 21.0287, Lng: 105.8524},
+			"7692":  {Lat: 10.7756, Lng: 106.7019},
+			"19469": {Lat: 16.0615, Lng: 108.2210},
+			"7948":  {Lat: 200, Lng: 106.7},
+		}
+		data, _ = json.Marshal(centroids)
+		return data
+	})
+
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/api/v1/geo/nearest?lat=10.78&lng=106.70&limit=2", nil)
+	router.ServeHTTP(w, req)
+	var response struct {
+		Data []models.NearestWard `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if w.Code != http.StatusOK || len(response.Data) != 2 {
+		t.Fatalf("Expected 2 wards, got %d %s", w.Code, w.Body.String())
+	}
+	nearest := response.Data[0]
+	if nearest.Ward.Code != "7692" || nearest.Province == nil || nearest.Province.Code != "12" || nearest.DistanceKm > 1 {
+		t.Errorf("Expected Phường Sài Gòn first, got %+v", nearest)
+	}
+	// Hải Châu is nearer to Hồ Chí Minh than Hoàn Kiếm
+	if response.Data[1].Ward.Code != "19469" || response.Data[1].DistanceKm <= nearest.DistanceKm {
+		t.Errorf("Expected Phường Hải Châu second, got %+v", response.Data[1])
+	}
+
+	// Invalid centroids are ignored, so ward 7948 has none
+	ward, _ := dataService.GetWard(context.Background(), "7948")
+	if ward == nil || ward.Centroid != nil {
+		t.Errorf("Expected ward 7948 without a centroid, got %+v", ward)
+	}
+	province, _ := dataService.GetProvince(context.Background(), "11")
+	if province == nil || province.Centroid == nil {
+		t.Errorf("Expected a centroid for Hà Nội, got %+v", province)
+	}
+
+	for _, query := range []string{"lng=106.7", "lat=91&lng=106.7", "lat=NaN&lng=106.7", "lat=10&lng=abc", "lat=10&lng=106&limit=51"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/geo/nearest?"+query, nil)
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for %s, got %d", query, w.Code)
+		}
+	}
+}
+
+func TestReverseGeocoding(t *testing.T) {
+	// The committed dataset has no boundaries, so reverse geocoding is unavailable
+	_, router := loadDataset(t)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/geo/reverse?lat=21.03&lng=105.85", nil)
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503 without boundaries, got %d", w.Code)
+	}
+
+	// Hoàn Kiếm is a square with a hole; its southern edge has many points
+	// for simplification. Phường Sài Gòn is a plain square, and boundaries of
+	// unknown wards are ignored.
+	southEdge := [][]float64{}
+	for i := 0; i <= 100; i++ {
+		southEdge = append(southEdge, []float64{105.84 + float64(i)*0.0002, 21.02 + float64(i%2)*0.00001})
+	}
+	hoanKiem := append(southEdge, []float64{105.86, 21.04}, []float64{105.84, 21.04}, []float64{105.84, 21.02})
+	hole := [][]float64{{105.845, 21.025}, {105.846, 21.025}, {105.846, 21.026}, {105.845, 21.026}, {105.845, 21.025}}
+	collection := map[string]interface{}{
+		"type": "FeatureCollection",
+		"features": []interface{}{
+			map[string]interface{}{"type": "Feature", "properties": map[string]interface{}{"code": "31755"},
+				"geometry": map[string]interface{}{"type": "Polygon", "coordinates": [][][]float64{hoanKiem, hole}}},
+			map[string]interface{}{"type": "Feature", "properties": map[string]interface{}{"code": 7692},
+				"geometry": map[string]interface{}{"type": "MultiPolygon", "coordinates": [][][][]float64{{{{106.69, 10.77}, {106.71, 10.77}, {106.71, 10.79}, {106.69, 10.79}, {106.69, 10.77}}}}}},
+			map[string]interface{}{"type": "Feature", "properties": map[string]interface{}{"code": "00000"},
+				"geometry": map[string]interface{}{"type": "Polygon", "coordinates": [][][]float64{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}},
+		},
+	}
+	boundaries, _ := json.Marshal(collection)
+	_, router = loadPatchedDataset(t, []string{"province.json", "ward.json", "boundaries.geojson"}, func(name string, data []byte) []byte {
+		if name == "boundaries.geojson" {
+			return boundaries
+		}
+		return data
+	})
+
+	reverse := func(query string) (int, models.ReverseGeocode) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/geo/reverse?"+query, nil)
+		router.ServeHTTP(w, req)
+		var response struct {
+			Data models.ReverseGeocode `json:"data"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return w.Code, response.Data
+	}
+	if code, result := reverse("lat=21.03&lng=105.85"); code != http.StatusOK || result.Ward.Code != "31755" ||
+		result.Province == nil || result.Province.Code != "11" {
+		t.Errorf("Expected Phường Hoàn Kiếm of Hà Nội, got %d %+v", code, result)
+	}
+	if code, result := reverse("lat=10.78&lng=106.70"); code != http.StatusOK || result.Ward.Code != "7692" {
+		t.Errorf("Expected Phường Sài Gòn, got %d %+v", code, result)
+	}
+	if code, _ := reverse("lat=21.0255&lng=105.8455"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 inside the hole, got %d", code)
+	}
+	if code, _ := reverse("lat=0.2&lng=0.5"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 where only an unknown ward was, got %d", code)
+	}
+	if code, _ := reverse("lat=21.03"); code != http.StatusBadRequest {
+		t.Errorf("Expected 400 without a longitude, got %d", code)
+	}
+
+	boundary := func(query string) (*httptest.ResponseRecorder, models.BoundaryFeature) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/wards/31755/boundary"+query, nil)
+		router.ServeHTTP(w, req)
+		var feature models.BoundaryFeature
+		if err := json.Unmarshal(w.Body.Bytes(), &feature); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		return w, feature
+	}
+	w, full := boundary("")
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "application/geo+json") ||
+		full.Type != "Feature" || full.Geometry.Type != "MultiPolygon" || full.Properties.Simplify != "none" ||
+		full.Properties.Points != 109 || len(full.Geometry.Coordinates[0]) != 2 {
+		t.Fatalf("Expected the full boundary with its hole, got %d %s", w.Code, w.Body.String())
+	}
+	if first := full.Geometry.Coordinates[0][0][0]; first != [2]float64{105.84, 21.02} {
+		t.Errorf("Expected [longitude, latitude] positions, got %v", first)
+	}
+	_, low := boundary("?simplify=low")
+	_, high := boundary("?simplify=high")
+	if low.Properties.Points >= full.Properties.Points || high.Properties.Points > low.Properties.Points {
+		t.Errorf("Expected fewer points at higher levels, got %d, %d and %d",
+			full.Properties.Points, low.Properties.Points, high.Properties.Points)
+	}
+	if w, _ := boundary("?simplify=extreme"); w.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an unknown level, got %d", w.Code)
+	}
+
+	for path, status := range map[string]int{"/api/v1/wards/7948/boundary": http.StatusNotFound, "/api/v1/wards/00000/boundary": http.StatusNotFound} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(w, req)
+		if w.Code != status {
+			t.Errorf("Expected %d for %s, got %d", status, path, w.Code)
+		}
+	}
+}
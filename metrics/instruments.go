@@ -0,0 +1,59 @@
+package metrics
+
+import "net/http"
+
+const namespace = "vietnam_admin_"
+
+// Default is the registry exposed on /metrics
+var Default = NewRegistry()
+
+// HTTP metrics
+var (
+	HTTPRequests = Default.NewCounterVec(namespace+"http_requests_total",
+		"Total number of HTTP requests by method, route template and status.",
+		"method", "route", "status")
+
+	HTTPRequestDuration = Default.NewHistogramVec(namespace+"http_request_duration_seconds",
+		"HTTP request latency in seconds by method, route template and status.",
+		DefaultBuckets, "method", "route", "status")
+
+	HTTPRequestsInFlight = Default.NewGaugeVec(namespace+"http_requests_in_flight",
+		"Number of HTTP requests currently being served.")
+)
+
+// Search metrics
+var (
+	SearchResults = Default.NewHistogramVec(namespace+"search_results",
+		"Number of results matched by a search, by entity.",
+		[]float64{0, 1, 5, 10, 20, 50, 100, 500, 1000, 5000}, "entity")
+)
+
+// Cache metrics
+var (
+	CacheRequests = Default.NewCounterVec(namespace+"cache_requests_total",
+		"Response cache lookups by result (hit or miss).",
+		"result")
+)
+
+// Data loading metrics
+var (
+	DataReloads = Default.NewCounterVec(namespace+"data_reloads_total",
+		"Total number of data loads by result (success or failure).",
+		"result")
+
+	DataReloadDuration = Default.NewHistogramVec(namespace+"data_reload_duration_seconds",
+		"Duration of data loads in seconds.",
+		[]float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10})
+
+	DatasetSize = Default.NewGaugeVec(namespace+"dataset_records",
+		"Number of records currently loaded, by entity.",
+		"entity")
+
+	LastReloadTimestamp = Default.NewGaugeVec(namespace+"data_last_reload_timestamp_seconds",
+		"Unix timestamp of the last successful data load.")
+)
+
+// Handler serves the default registry in Prometheus text format
+func Handler() http.Handler {
+	return Default.Handler()
+}
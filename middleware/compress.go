@@ -0,0 +1,359 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/cache"
+)
+
+// Content codings produced by Compression
+const (
+	EncodingGzip   = "gzip"
+	EncodingBrotli = "br"
+)
+
+// cacheableKey marks a request whose response only depends on the URL and the
+// dataset version, so its compressed body may be reused
+const cacheableKey = "response_cacheable"
+
+// CompressionConfig configures response compression.
+type CompressionConfig struct {
+	// MinSize is the smallest body, in bytes, worth compressing
+	MinSize int
+	// GzipLevel is a compress/gzip level (1-9, or -1 for the default)
+	GzipLevel int
+	// Brotli enables the "br" coding for clients that accept it
+	Brotli bool
+	// BrotliLevel is the brotli quality (0-11)
+	BrotliLevel int
+	// ExcludedPaths are path prefixes that are never compressed, such as
+	// streaming endpoints
+	ExcludedPaths []string
+	// Version returns the current dataset version. When set, compressed
+	// bodies of requests marked with Cacheable are kept until it changes.
+	Version func() string
+	// CacheMaxBytes bounds the memory used by pre-compressed bodies
+	CacheMaxBytes int
+}
+
+// DefaultCompressionConfig returns gzip compression at the default level for
+// bodies of 1 KiB or more, with brotli disabled
+func DefaultCompressionConfig() CompressionConfig {
+	return CompressionConfig{
+		MinSize:       1024,
+		GzipLevel:     gzip.DefaultCompression,
+		BrotliLevel:   5,
+		CacheMaxBytes: 32 << 20,
+	}
+}
+
+// Cacheable marks the responses of a route group as depending only on the
+// request URL and the loaded dataset, allowing them to be pre-compressed.
+// HTTPCache marks the routes it manages the same way.
+func Cacheable() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(cacheableKey, true)
+		c.Next()
+	}
+}
+
+// Compression returns a middleware that compresses responses with gzip or
+// brotli according to the client's Accept-Encoding header.
+func Compression(config CompressionConfig) gin.HandlerFunc {
+	precompressed := newCompressedCache(config.CacheMaxBytes)
+	gzipPool := sync.Pool{New: func() interface{} {
+		w, err := gzip.NewWriterLevel(io.Discard, config.GzipLevel)
+		if err != nil {
+			w = gzip.NewWriter(io.Discard)
+		}
+		return w
+	}}
+
+	compress := func(encoding string, body []byte) []byte {
+		var buf bytes.Buffer
+		buf.Grow(len(body) / 4)
+		switch encoding {
+		case EncodingBrotli:
+			bw := brotli.NewWriterLevel(&buf, config.BrotliLevel)
+			bw.Write(body)
+			bw.Close()
+		default:
+			gw := gzipPool.Get().(*gzip.Writer)
+			gw.Reset(&buf)
+			gw.Write(body)
+			gw.Close()
+			gzipPool.Put(gw)
+		}
+		return buf.Bytes()
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead || hasPathPrefix(c.Request.URL.Path, config.ExcludedPaths) {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"), config.Brotli)
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		version, cacheKey := "", ""
+		if config.Version != nil && c.Request.Method == http.MethodGet {
+			version = config.Version()
+			cacheKey = encoding + "|" + c.Request.URL.RequestURI() + "|" + c.GetHeader("Accept-Language")
+			if entry, ok := precompressed.get(version, cacheKey); ok {
+				header := c.Writer.Header()
+				header.Set("Content-Type", entry.contentType)
+				header.Set("Content-Encoding", encoding)
+				header.Set("Content-Length", strconv.Itoa(len(entry.body)))
+				setETagEncoding(header, encoding)
+				c.Status(http.StatusOK)
+				c.Writer.Write(entry.body)
+				c.Abort()
+				return
+			}
+		}
+
+		writer := &compressWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = writer
+		defer func() { c.Writer = writer.ResponseWriter }()
+
+		c.Next()
+
+		if writer.passthrough {
+			return
+		}
+
+		body := writer.buf.Bytes()
+		header := writer.Header()
+		if len(body) < config.MinSize || !bodyAllowedForStatus(writer.status) ||
+			header.Get("Content-Encoding") != "" || isStreamingContentType(header.Get("Content-Type")) {
+			writer.flushRaw()
+			return
+		}
+
+		compressed := compress(encoding, body)
+		header.Set("Content-Encoding", encoding)
+		header.Set("Content-Length", strconv.Itoa(len(compressed)))
+		setETagEncoding(header, encoding)
+		writer.ResponseWriter.WriteHeader(writer.status)
+		writer.ResponseWriter.Write(compressed)
+
+		if cacheKey != "" && writer.status == http.StatusOK && c.GetBool(cacheableKey) &&
+			!strings.Contains(header.Get("Cache-Control"), "no-store") {
+			precompressed.put(version, cacheKey, compressedEntry{
+				contentType: header.Get("Content-Type"),
+				body:        compressed,
+			})
+		}
+	}
+}
+
+// compressWriter buffers the response body so the middleware can decide
+// whether to compress it once the handler has finished. Flushing switches it
+// to pass-through mode so streamed responses are never held back.
+type compressWriter struct {
+	gin.ResponseWriter
+	buf         bytes.Buffer
+	status      int
+	wroteHeader bool
+	passthrough bool
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if code > 0 {
+		w.status = code
+		w.wroteHeader = true
+	}
+}
+
+func (w *compressWriter) WriteHeaderNow() {
+	if w.passthrough {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	w.wroteHeader = true
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+	w.wroteHeader = true
+	return w.buf.Write(data)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compressWriter) Status() int {
+	if w.passthrough {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+func (w *compressWriter) Size() int {
+	if w.passthrough {
+		return w.ResponseWriter.Size()
+	}
+	if !w.wroteHeader {
+		return -1
+	}
+	return w.buf.Len()
+}
+
+func (w *compressWriter) Written() bool {
+	if w.passthrough {
+		return w.ResponseWriter.Written()
+	}
+	return w.wroteHeader
+}
+
+// Flush sends buffered data uncompressed and disables compression for the
+// rest of the response
+func (w *compressWriter) Flush() {
+	if !w.passthrough {
+		w.flushRaw()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressWriter) flushRaw() {
+	w.passthrough = true
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.buf.Len() > 0 {
+		w.ResponseWriter.Write(w.buf.Bytes())
+		w.buf.Reset()
+	}
+}
+
+// negotiateEncoding picks the preferred supported coding from an
+// Accept-Encoding header, honouring q-values. It returns "" for identity.
+func negotiateEncoding(acceptEncoding string, allowBrotli bool) string {
+	weights := map[string]float64{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		if name, q := parseQualityValue(part); name != "" {
+			weights[name] = q
+		}
+	}
+
+	// A "*" entry applies to codings that are not listed explicitly
+	weight := func(name string) float64 {
+		if q, ok := weights[name]; ok {
+			return q
+		}
+		return weights["*"]
+	}
+
+	best, bestQ := "", 0.0
+	if q := weight(EncodingGzip); q > bestQ {
+		best, bestQ = EncodingGzip, q
+	}
+	// Prefer brotli on ties since it compresses JSON better
+	if q := weight(EncodingBrotli); allowBrotli && q > 0 && q >= bestQ {
+		best = EncodingBrotli
+	}
+	return best
+}
+
+// parseQualityValue splits "gzip;q=0.8" into its lowercased name and weight
+func parseQualityValue(part string) (string, float64) {
+	name, params, _ := strings.Cut(part, ";")
+	name = strings.ToLower(strings.TrimSpace(name))
+	q := 1.0
+	for _, param := range strings.Split(params, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok && strings.EqualFold(key, "q") {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				q = parsed
+			}
+		}
+	}
+	return name, q
+}
+
+// setETagEncoding distinguishes the ETag of an encoded representation from
+// the identity one, as strong validators must differ between them
+func setETagEncoding(header http.Header, encoding string) {
+	if etag := header.Get("ETag"); etag != "" {
+		header.Set("ETag", withETagEncoding(etag, encoding))
+	}
+}
+
+func hasPathPrefix(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isStreamingContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/event-stream") ||
+		strings.HasPrefix(contentType, "application/x-ndjson")
+}
+
+func bodyAllowedForStatus(status int) bool {
+	switch {
+	case status >= 100 && status <= 199:
+		return false
+	case status == http.StatusNoContent, status == http.StatusNotModified:
+		return false
+	}
+	return true
+}
+
+// compressedCache keeps compressed bodies in a byte-bounded LRU, so that a
+// full cache evicts rarely requested URLs instead of refusing hot ones. Keys
+// are scoped to the dataset version: entries of a replaced dataset are never
+// served again and are the first to be evicted.
+type compressedCache struct {
+	lru *cache.LRU
+}
+
+type compressedEntry struct {
+	contentType string
+	body        []byte
+}
+
+func newCompressedCache(maxBytes int) *compressedCache {
+	return &compressedCache{lru: cache.NewLRU(int64(maxBytes))}
+}
+
+// The LRU holds byte slices, so an entry is stored as its content type and
+// body separated by a NUL byte, which cannot occur in a header value
+func (cc *compressedCache) get(version, key string) (compressedEntry, bool) {
+	value, ok := cc.lru.Get(version + "|" + key)
+	if !ok {
+		return compressedEntry{}, false
+	}
+	contentType, body, _ := bytes.Cut(value, []byte{0})
+	return compressedEntry{contentType: string(contentType), body: body}, true
+}
+
+func (cc *compressedCache) put(version, key string, entry compressedEntry) {
+	value := make([]byte, 0, len(entry.contentType)+1+len(entry.body))
+	value = append(value, entry.contentType...)
+	value = append(value, 0)
+	value = append(value, entry.body...)
+	cc.lru.Set(version+"|"+key, value)
+}
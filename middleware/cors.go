@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CORSConfig describes the cross-origin policy applied by CORS.
+//
+// AllowOrigins entries may be an exact origin ("https://app.example.com"),
+// a wildcard subdomain pattern ("https://*.example.com") or "*" to allow any
+// origin. Credentials are never granted to origins that only match "*".
+type CORSConfig struct {
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+// DefaultCORSConfig returns a policy allowing any origin without credentials
+// for the methods the API actually serves.
+func DefaultCORSConfig() CORSConfig {
+	return CORSConfig{
+		AllowOrigins:  []string{"*"},
+		AllowMethods:  []string{http.MethodGet, http.MethodPost, http.MethodOptions},
+		AllowHeaders:  []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-Requested-With", RequestIDHeader},
+		ExposeHeaders: []string{"Content-Length", RequestIDHeader},
+		MaxAge:        12 * time.Hour,
+	}
+}
+
+// originMatcher matches request origins against the configured allow list.
+type originMatcher struct {
+	allowAll bool
+	exact    map[string]bool
+	patterns [][2]string // prefix and suffix around the "*" of a pattern
+}
+
+func newOriginMatcher(origins []string) originMatcher {
+	m := originMatcher{exact: make(map[string]bool)}
+	for _, origin := range origins {
+		origin = strings.ToLower(strings.TrimSpace(origin))
+		switch {
+		case origin == "":
+		case origin == "*":
+			m.allowAll = true
+		case strings.Contains(origin, "*"):
+			i := strings.Index(origin, "*")
+			m.patterns = append(m.patterns, [2]string{origin[:i], origin[i+1:]})
+		default:
+			m.exact[strings.TrimSuffix(origin, "/")] = true
+		}
+	}
+	return m
+}
+
+// match reports whether the origin is allowed and whether it was matched by
+// an explicit entry (exact or pattern) rather than the "*" wildcard.
+func (m originMatcher) match(origin string) (allowed, explicit bool) {
+	origin = strings.ToLower(origin)
+	if m.exact[origin] {
+		return true, true
+	}
+	for _, p := range m.patterns {
+		// The wildcard must cover at least one subdomain label, so
+		// "https://*.example.com" does not match "https://example.com".
+		if len(origin) > len(p[0])+len(p[1]) &&
+			strings.HasPrefix(origin, p[0]) && strings.HasSuffix(origin, p[1]) {
+			return true, true
+		}
+	}
+	return m.allowAll, false
+}
+
+// CORS returns a gin middleware enforcing the given cross-origin policy.
+// Allowed origins are echoed back with "Vary: Origin" so shared caches keep
+// per-origin responses apart.
+func CORS(config CORSConfig) gin.HandlerFunc {
+	matcher := newOriginMatcher(config.AllowOrigins)
+	allowMethods := strings.Join(config.AllowMethods, ", ")
+	allowHeaders := strings.Join(config.AllowHeaders, ", ")
+	exposeHeaders := strings.Join(config.ExposeHeaders, ", ")
+	maxAge := ""
+	if config.MaxAge > 0 {
+		maxAge = strconv.Itoa(int(config.MaxAge.Seconds()))
+	}
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			// Not a cross-origin request
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Origin")
+		preflight := c.Request.Method == http.MethodOptions &&
+			c.GetHeader("Access-Control-Request-Method") != ""
+
+		allowed, explicit := matcher.match(origin)
+		if !allowed {
+			if preflight {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		c.Header("Access-Control-Allow-Origin", origin)
+		if config.AllowCredentials && explicit {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		if !preflight {
+			if exposeHeaders != "" {
+				c.Header("Access-Control-Expose-Headers", exposeHeaders)
+			}
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Access-Control-Request-Method")
+		c.Writer.Header().Add("Vary", "Access-Control-Request-Headers")
+		c.Header("Access-Control-Allow-Methods", allowMethods)
+		if allowHeaders != "" {
+			c.Header("Access-Control-Allow-Headers", allowHeaders)
+		}
+		if maxAge != "" {
+			c.Header("Access-Control-Max-Age", maxAge)
+		}
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
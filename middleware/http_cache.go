@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HTTPCacheConfig configures ETag, Last-Modified and Cache-Control handling
+// for responses derived from the loaded dataset.
+type HTTPCacheConfig struct {
+	// Paths are the path prefixes whose GET responses are cacheable
+	Paths []string
+	// Checksum returns the checksum of the loaded dataset
+	Checksum func() string
+	// LastModified returns when the dataset was loaded
+	LastModified func() time.Time
+	// CacheControl is sent with cacheable responses, e.g. "public, max-age=300"
+	CacheControl string
+	// VaryHeaders are request headers the response depends on. Their values
+	// are mixed into the ETag and listed in the Vary header.
+	VaryHeaders []string
+}
+
+// HTTPCache returns a middleware that assigns a strong ETag derived from the
+// dataset checksum and the request parameters, and answers conditional
+// requests (If-None-Match, If-Modified-Since) with 304 Not Modified before
+// the handler runs. Cache headers are removed again from non-200 responses.
+func HTTPCache(config HTTPCacheConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		method := c.Request.Method
+		if (method != http.MethodGet && method != http.MethodHead) ||
+			c.FullPath() == "" || !hasPathPrefix(c.Request.URL.Path, config.Paths) {
+			c.Next()
+			return
+		}
+
+		checksum := config.Checksum()
+		if checksum == "" {
+			// Nothing loaded yet, so there is no stable representation
+			c.Next()
+			return
+		}
+
+		c.Set(cacheableKey, true)
+		etag := computeETag(checksum, c.Request, config.VaryHeaders)
+		lastModified := config.LastModified().UTC().Truncate(time.Second)
+
+		header := c.Writer.Header()
+		header.Set("ETag", etag)
+		header.Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		if config.CacheControl != "" {
+			header.Set("Cache-Control", config.CacheControl)
+		}
+		for _, name := range config.VaryHeaders {
+			header.Add("Vary", name)
+		}
+
+		if notModified(c.Request, etag, lastModified) {
+			c.AbortWithStatus(http.StatusNotModified)
+			return
+		}
+
+		c.Writer = &cacheHeaderWriter{ResponseWriter: c.Writer}
+		c.Next()
+	}
+}
+
+// cacheHeaderWriter strips validators and caching directives from responses
+// that turn out not to be successful, so errors are never cached as if they
+// were the representation identified by the ETag.
+type cacheHeaderWriter struct {
+	gin.ResponseWriter
+}
+
+func (w *cacheHeaderWriter) WriteHeader(code int) {
+	if code != http.StatusOK && code != http.StatusNotModified {
+		header := w.Header()
+		header.Del("ETag")
+		header.Del("Last-Modified")
+		header.Set("Cache-Control", "no-store")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// computeETag hashes the dataset checksum, the path, the query parameters in
+// canonical order and the values of the vary headers
+func computeETag(checksum string, req *http.Request, varyHeaders []string) string {
+	hash := sha256.New()
+	hash.Write([]byte(checksum))
+	hash.Write([]byte{0})
+	hash.Write([]byte(req.URL.Path))
+	hash.Write([]byte{0})
+	hash.Write([]byte(canonicalQuery(req.URL.Query())))
+	for _, name := range varyHeaders {
+		hash.Write([]byte{0})
+		hash.Write([]byte(req.Header.Get(name)))
+	}
+	return `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// canonicalQuery encodes query parameters sorted by key, keeping the order
+// of repeated values, and ignoring empty values
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		for _, value := range query[key] {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(url.QueryEscape(key))
+			b.WriteByte('=')
+			b.WriteString(url.QueryEscape(value))
+		}
+	}
+	return b.String()
+}
+
+// notModified evaluates the conditional request headers. If-None-Match takes
+// precedence over If-Modified-Since as required by RFC 9110.
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		return etagListMatches(inm, etag)
+	}
+	if ims := req.Header.Get("If-Modified-Since"); ims != "" {
+		if t, err := http.ParseTime(ims); err == nil {
+			return !lastModified.After(t)
+		}
+	}
+	return false
+}
+
+// etagListMatches compares an If-None-Match list against etag using the weak
+// comparison. Encoding suffixes added by Compression ("-gzip", "-br") are
+// ignored so compressed and identity responses revalidate alike.
+func etagListMatches(list, etag string) bool {
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		candidate = strings.TrimPrefix(candidate, "W/")
+		if candidate == etag || stripETagEncoding(candidate) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// withETagEncoding marks a strong ETag as belonging to an encoded
+// representation, e.g. "abc" becomes "abc-gzip"
+func withETagEncoding(etag, encoding string) string {
+	if len(etag) < 2 || !strings.HasSuffix(etag, `"`) {
+		return etag
+	}
+	return etag[:len(etag)-1] + "-" + encoding + `"`
+}
+
+func stripETagEncoding(etag string) string {
+	for _, encoding := range []string{EncodingGzip, EncodingBrotli} {
+		suffix := "-" + encoding + `"`
+		if strings.HasSuffix(etag, suffix) {
+			return etag[:len(etag)-len(suffix)] + `"`
+		}
+	}
+	return etag
+}
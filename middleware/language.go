@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/i18n"
+	"vietnam-admin-api/models"
+)
+
+const (
+	// LanguageParam is the query parameter selecting the response language
+	LanguageParam = "lang"
+
+	// LanguageKey is the gin.Context key holding the response language
+	LanguageKey = "language"
+)
+
+// Language returns a middleware selecting the language of response messages
+// from the lang query parameter or, without one, the Accept-Language header.
+// Unsupported lang values are rejected with 400; unsupported Accept-Language
+// values fall back to the default catalog's fallback language.
+func Language() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		catalog := i18n.Default()
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		lang := catalog.Match(c.GetHeader("Accept-Language"))
+		if param := strings.ToLower(strings.TrimSpace(c.Query(LanguageParam))); param != "" {
+			if !catalog.Supports(param) {
+				message := catalog.Message(lang, "violation.one_of",
+					"field", LanguageParam, "values", strings.Join(catalog.Languages(), ", "))
+				c.AbortWithStatusJSON(http.StatusBadRequest, models.NewErrorResponse(
+					models.ErrCodeInvalidParameter, message, GetRequestID(c),
+					models.FieldViolation{Field: LanguageParam, Message: message}))
+				return
+			}
+			lang = param
+		}
+
+		c.Set(LanguageKey, lang)
+		c.Header("Content-Language", lang)
+		c.Next()
+	}
+}
+
+// GetLanguage returns the response language of the current request
+func GetLanguage(c *gin.Context) string {
+	if lang := c.GetString(LanguageKey); lang != "" {
+		return lang
+	}
+	return i18n.Default().Fallback()
+}
+
+// Message returns a message of the default catalog in the response language
+func Message(c *gin.Context, key string, args ...interface{}) string {
+	return i18n.Default().Message(GetLanguage(c), key, args...)
+}
@@ -1,14 +1,21 @@
 package middleware
 
 import (
-	"log"
+	"crypto/subtle"
+	"log/slog"
 	"net/http"
+	"runtime/debug"
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/models"
 )
 
-// Logger returns a Gin middleware for logging HTTP requests.
+// Logger returns a Gin middleware for logging HTTP requests as structured
+// slog records. Server errors are logged at error level, client errors at
+// warn level and everything else at info level.
 func Logger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
@@ -17,42 +24,34 @@ func Logger() gin.HandlerFunc {
 
 		c.Next()
 
-		latency := time.Since(start)
-		clientIP := c.ClientIP()
-		method := c.Request.Method
 		statusCode := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case statusCode >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case statusCode >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
 
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", statusCode),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("bytes", c.Writer.Size()),
+			slog.String("client_ip", c.ClientIP()),
+			slog.String("user_agent", c.Request.UserAgent()),
+		}
 		if raw != "" {
-			path = path + "?" + raw
+			attrs = append(attrs, slog.String("query", raw))
 		}
-
-		log.Printf("[%s] %s %s %d %v %s",
-			time.Now().Format("2006/01/02 15:04:05"),
-			method,
-			path,
-			statusCode,
-			latency,
-			clientIP,
-		)
-	}
-}
-
-// CORS returns a gin middleware for CORS
-func CORS() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.Header("Access-Control-Allow-Origin", "*")
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Origin, Content-Length, Content-Type, Authorization, X-Requested-With")
-		c.Header("Access-Control-Expose-Headers", "Content-Length")
-		c.Header("Access-Control-Allow-Credentials", "true")
-		c.Header("Access-Control-Max-Age", "43200") // 12 hours
-
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(http.StatusNoContent)
-			return
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("errors", c.Errors.String()))
 		}
 
-		c.Next()
+		ctx := c.Request.Context()
+		logging.FromContext(ctx).LogAttrs(ctx, level, "http request", attrs...)
 	}
 }
 
@@ -65,19 +64,14 @@ func RateLimit() gin.HandlerFunc {
 	}
 }
 
-// AdminAuth returns a middleware for admin authentication
-func AdminAuth() gin.HandlerFunc {
+// AdminAuth returns a middleware that admits only requests carrying token
+// as a bearer token
+func AdminAuth(token string) gin.HandlerFunc {
+	expected := []byte("Bearer " + token)
 	return func(c *gin.Context) {
-		// Simple token-based auth (implement proper auth as needed)
-		token := c.GetHeader("Authorization")
-
-		// Example: Bearer admin-secret-token
-		if token != "Bearer admin-secret-token" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"success": false,
-				"message": "Unauthorized access",
-			})
-			c.Abort()
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader("Authorization")), expected) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized,
+				models.NewErrorResponse(models.ErrCodeUnauthorized, Message(c, "error.unauthorized"), GetRequestID(c)))
 			return
 		}
 
@@ -90,11 +84,12 @@ func Recovery() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				log.Printf("Panic recovered: %v", err)
-				c.JSON(http.StatusInternalServerError, gin.H{
-					"success": false,
-					"message": "Internal server error",
-				})
+				logging.FromContext(c.Request.Context()).Error("panic recovered",
+					"error", err,
+					"stack", string(debug.Stack()),
+				)
+				c.AbortWithStatusJSON(http.StatusInternalServerError,
+					models.NewErrorResponse(models.ErrCodeInternal, Message(c, "error.internal"), GetRequestID(c)))
 			}
 		}()
 		c.Next()
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// Aliases lists the alternative names and abbreviations of provinces and
+// wards by code, such as "TP.HCM" and "Sài Gòn" for Hồ Chí Minh
+type Aliases struct {
+	Provinces map[string][]string `json:"provinces"`
+	Wards     map[string][]string `json:"wards"`
+}
+
+// AliasesRequest replaces the aliases of a province or ward; an empty list
+// removes them
+type AliasesRequest struct {
+	Aliases []string `json:"aliases" binding:"required"`
+}
+
+// UnitAliases are the aliases of one province or ward
+type UnitAliases struct {
+	Kind    string   `json:"kind"`
+	Code    string   `json:"code"`
+	Aliases []string `json:"aliases"`
+}
+
+// AliasKey normalizes a name for alias matching: diacritics, case,
+// punctuation and spaces are ignored, so "TP.HCM", "tp hcm" and "TPHCM"
+// are the same alias
+func AliasKey(name string) string {
+	var b strings.Builder
+	for _, r := range ASCIIName(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// Clone returns a deep copy of the aliases
+func (a Aliases) Clone() Aliases {
+	return Aliases{Provinces: cloneAliasMap(a.Provinces), Wards: cloneAliasMap(a.Wards)}
+}
+
+func cloneAliasMap(m map[string][]string) map[string][]string {
+	clone := make(map[string][]string, len(m))
+	for code, aliases := range m {
+		clone[code] = append([]string(nil), aliases...)
+	}
+	return clone
+}
+
+// UnmarshalAliases parses aliases.json
+func UnmarshalAliases(data []byte) (Aliases, error) {
+	var aliases Aliases
+	err := json.Unmarshal(data, &aliases)
+	return aliases, err
+}
@@ -0,0 +1,60 @@
+package models
+
+// ErrorCode is a stable, machine-readable error identifier. Messages may
+// change; codes do not.
+type ErrorCode string
+
+const (
+	// ErrCodeInvalidParameter is returned for query or path parameters that
+	// cannot be parsed or are out of range; Details lists each violation
+	ErrCodeInvalidParameter ErrorCode = "invalid_parameter"
+	// ErrCodeInvalidBody is returned for malformed or incomplete request
+	// bodies; Details lists each violation when the body could be parsed
+	ErrCodeInvalidBody ErrorCode = "invalid_body"
+	// ErrCodeUnauthorized is returned for admin requests without valid
+	// credentials
+	ErrCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrCodeNotFound is returned when the requested resource does not exist
+	ErrCodeNotFound ErrorCode = "not_found"
+	// ErrCodeRouteNotFound is returned for requests to unknown endpoints
+	ErrCodeRouteNotFound ErrorCode = "route_not_found"
+	// ErrCodeStaleCursor is returned for cursors issued before a data reload
+	ErrCodeStaleCursor ErrorCode = "stale_cursor"
+	// ErrCodeDataUnavailable is returned while no data is loaded
+	ErrCodeDataUnavailable ErrorCode = "data_unavailable"
+	// ErrCodeInternal is returned for unexpected server errors
+	ErrCodeInternal ErrorCode = "internal_error"
+)
+
+// ErrorResponse is the envelope of every error response
+type ErrorResponse struct {
+	Success bool     `json:"success"`
+	Error   APIError `json:"error"`
+}
+
+// APIError describes why a request failed
+type APIError struct {
+	Code      ErrorCode        `json:"code"`
+	Message   string           `json:"message"`
+	Details   []FieldViolation `json:"details,omitempty"`
+	RequestID string           `json:"request_id,omitempty"`
+}
+
+// FieldViolation is a problem with a single parameter or body field
+type FieldViolation struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// NewErrorResponse returns the envelope for an error
+func NewErrorResponse(code ErrorCode, message, requestID string, details ...FieldViolation) ErrorResponse {
+	return ErrorResponse{
+		Success: false,
+		Error: APIError{
+			Code:      code,
+			Message:   message,
+			Details:   details,
+			RequestID: requestID,
+		},
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+import "encoding/json"
+
+// Coordinates is a WGS 84 latitude and longitude
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Centroids lists the centroids of provinces and wards by code
+type Centroids struct {
+	Provinces map[string]Coordinates `json:"provinces"`
+	Wards     map[string]Coordinates `json:"wards"`
+}
+
+// NearestWard is a ward found near a point, with its province and the
+// distance from the point to its centroid
+type NearestWard struct {
+	Ward       Ward      `json:"ward"`
+	Province   *Province `json:"province,omitempty"`
+	DistanceKm float64   `json:"distance_km"`
+}
+
+// UnmarshalCentroids parses centroids.json
+func UnmarshalCentroids(data []byte) (Centroids, error) {
+	var centroids Centroids
+	err := json.Unmarshal(data, &centroids)
+	return centroids, err
+}
+
+// ReverseGeocode is the ward whose boundary contains a point, with its
+// province
+type ReverseGeocode struct {
+	Ward     Ward      `json:"ward"`
+	Province *Province `json:"province,omitempty"`
+}
+
+// BoundaryFeature is the boundary of a ward as a GeoJSON Feature
+type BoundaryFeature struct {
+	Type       string             `json:"type"`
+	Properties BoundaryProperties `json:"properties"`
+	Geometry   BoundaryGeometry   `json:"geometry"`
+}
+
+// BoundaryProperties identify the ward of a boundary and how it was simplified
+type BoundaryProperties struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	NameWithType string `json:"name_with_type"`
+	ParentCode   string `json:"parent_code"`
+	Simplify     string `json:"simplify"`
+	Points       int    `json:"points"`
+}
+
+// BoundaryGeometry is a GeoJSON MultiPolygon of [longitude, latitude]
+// positions
+type BoundaryGeometry struct {
+	Type        string           `json:"type"`
+	Coordinates [][][][2]float64 `json:"coordinates"`
+}
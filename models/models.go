@@ -8,23 +8,55 @@ import (
 
 // Province represents a Vietnamese province/city
 type Province struct {
-	Code         string `json:"code"`
-	Name         string `json:"name"`
-	Slug         string `json:"slug"`
-	Type         string `json:"type"`
-	NameWithType string `json:"name_with_type"`
+	Code           string `json:"code"`
+	Name           string `json:"name"`
+	Slug           string `json:"slug"`
+	Type           string `json:"type"`
+	NameWithType   string `json:"name_with_type"`
+	NameEn         string `json:"name_en"`
+	NameASCII      string `json:"name_ascii"`
+	NameWithTypeEn string `json:"name_with_type_en"`
+
+	// Administrative metadata; unknown values are empty
+	Region         string   `json:"region"`
+	EconomicRegion string   `json:"economic_region"`
+	PhoneCodes     []string `json:"phone_codes"`
+	PlateCodes     []string `json:"plate_codes"`
+	PostalCodes    []string `json:"postal_codes"`
+
+	// Centroid is null when unknown
+	Centroid *Coordinates `json:"centroid"`
+
+	// MatchedAlias is set on search results matched through an alias
+	MatchedAlias string `json:"matched_alias,omitempty"`
 }
 
 // Ward represents a Vietnamese ward/commune/town
 type Ward struct {
-	Code         string `json:"code"`
-	Name         string `json:"name"`
-	Slug         string `json:"slug"`
-	Type         string `json:"type"`
-	NameWithType string `json:"name_with_type"`
-	Path         string `json:"path"`
-	PathWithType string `json:"path_with_type"`
-	ParentCode   string `json:"parent_code"`
+	Code           string `json:"code"`
+	Name           string `json:"name"`
+	Slug           string `json:"slug"`
+	Type           string `json:"type"`
+	NameWithType   string `json:"name_with_type"`
+	Path           string `json:"path"`
+	PathWithType   string `json:"path_with_type"`
+	ParentCode     string `json:"parent_code"`
+	NameEn         string `json:"name_en"`
+	NameASCII      string `json:"name_ascii"`
+	NameWithTypeEn string `json:"name_with_type_en"`
+
+	// PostalCode is empty when the ward's own code is unknown
+	PostalCode string `json:"postal_code"`
+
+	// Centroid is null when unknown
+	Centroid *Coordinates `json:"centroid"`
+
+	// MatchedAlias is set on search results matched through an alias
+	MatchedAlias string `json:"matched_alias,omitempty"`
+
+	// English paths, generated by FillNames
+	pathEn         string
+	pathWithTypeEn string
 }
 
 // ProvinceData represents the structure of province.json
@@ -38,7 +70,6 @@ type APIResponse struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
 	Message string      `json:"message,omitempty"`
-	Error   string      `json:"error,omitempty"`
 }
 
 type PaginatedResponse struct {
@@ -49,17 +80,21 @@ type PaginatedResponse struct {
 }
 
 type Pagination struct {
-	Total  int `json:"total"`
-	Limit  int `json:"limit"`
-	Offset int `json:"offset"`
-	Pages  int `json:"pages"`
+	Total      int    `json:"total"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	Pages      int    `json:"pages"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
+// SearchResponse is the result of a global search. ConvertedQuery is the
+// query searched for when ?ime= converted it.
 type SearchResponse struct {
-	Success bool       `json:"success"`
-	Data    SearchData `json:"data"`
-	Query   string     `json:"query"`
-	Message string     `json:"message,omitempty"`
+	Success        bool       `json:"success"`
+	Data           SearchView `json:"data"`
+	Query          string     `json:"query"`
+	ConvertedQuery string     `json:"converted_query,omitempty"`
+	Message        string     `json:"message,omitempty"`
 }
 
 type SearchData struct {
@@ -67,16 +102,87 @@ type SearchData struct {
 	Wards     []Ward     `json:"wards"`
 }
 
+// SearchView is SearchData with the requested projection applied
+type SearchView struct {
+	Provinces []ProvinceView `json:"provinces"`
+	Wards     []WardView     `json:"wards"`
+}
+
+// ProvinceWithWards is a province together with its wards
+type ProvinceWithWards struct {
+	Province Province
+	Wards    []Ward
+}
+
+// TreeResponse is the nested province to wards tree
+type TreeResponse struct {
+	Success bool               `json:"success"`
+	Version string             `json:"version"`
+	Data    []ProvinceTreeView `json:"data"`
+}
+
+// CompactTreeResponse is the tree encoded as arrays. Each province is an
+// array of the values of Fields.Province followed by an array of its wards,
+// each an array of the values of Fields.Ward.
+type CompactTreeResponse struct {
+	Success bool          `json:"success"`
+	Version string        `json:"version"`
+	Fields  CompactFields `json:"fields"`
+	Data    []interface{} `json:"data"`
+}
+
+// CompactFields names the positions of values in a compact tree
+type CompactFields struct {
+	Province []string `json:"province"`
+	Ward     []string `json:"ward"`
+}
+
+// StreamTrailer is the last record of an NDJSON stream. It is wrapped in a
+// "trailer" key so it cannot be mistaken for a data record.
+type StreamTrailer struct {
+	Trailer StreamSummary `json:"trailer"`
+}
+
+// StreamSummary describes the records written before a StreamTrailer
+type StreamSummary struct {
+	Version string `json:"version"`
+	Count   int    `json:"count"`
+}
+
+// ValidationRequest designates the province and ward by code or, when the
+// code is empty, by name or alias
 type ValidationRequest struct {
-	ProvinceCode string `json:"province_code" binding:"required"`
-	WardCode     string `json:"ward_code" binding:"required"`
+	ProvinceCode string `json:"province_code,omitempty" binding:"required_without=ProvinceName"`
+	ProvinceName string `json:"province_name,omitempty"`
+	WardCode     string `json:"ward_code,omitempty" binding:"required_without=WardName"`
+	WardName     string `json:"ward_name,omitempty"`
+	// PostalCode is checked against the ward when given
+	PostalCode string `json:"postal_code,omitempty"`
+}
+
+// Address returns the units designated by the request
+func (r ValidationRequest) Address() AddressQuery {
+	return AddressQuery{ProvinceCode: r.ProvinceCode, ProvinceName: r.ProvinceName, WardCode: r.WardCode, WardName: r.WardName}
+}
+
+// AddressQuery designates the province and ward of an address to validate.
+// Each unit is given by its code or, when the code is empty, by its name.
+type AddressQuery struct {
+	ProvinceCode string
+	ProvinceName string
+	WardCode     string
+	WardName     string
 }
 
 type ValidationResponse struct {
-	Success bool   `json:"success"`
-	Valid   bool   `json:"valid"`
-	Data    *Ward  `json:"data,omitempty"`
-	Message string `json:"message,omitempty"`
+	Success bool  `json:"success"`
+	Valid   bool  `json:"valid"`
+	Data    *Ward `json:"data,omitempty"`
+	// Province is the ward's province when the address is valid
+	Province *Province `json:"province,omitempty"`
+	// PostalCodeValid is set when the request has a postal code
+	PostalCodeValid *bool  `json:"postal_code_valid,omitempty"`
+	Message         string `json:"message,omitempty"`
 }
 
 type HealthResponse struct {
@@ -89,8 +195,17 @@ type HealthResponse struct {
 }
 
 type Services struct {
-	DataLoader string `json:"data_loader"`
-	Cache      string `json:"cache,omitempty"`
+	DataLoader string       `json:"data_loader"`
+	Cache      *CacheHealth `json:"cache,omitempty"`
+}
+
+type CacheHealth struct {
+	Status  string  `json:"status"`
+	Hits    uint64  `json:"hits"`
+	Misses  uint64  `json:"misses"`
+	HitRate float64 `json:"hit_rate"`
+	Entries int     `json:"entries"`
+	Bytes   int64   `json:"bytes"`
 }
 
 // Search methods for Province
@@ -98,7 +213,10 @@ func (p Province) MatchesQuery(query string) bool {
 	query = strings.ToLower(query)
 	return strings.Contains(strings.ToLower(p.Name), query) ||
 		strings.Contains(strings.ToLower(p.Slug), query) ||
-		strings.Contains(strings.ToLower(p.NameWithType), query)
+		strings.Contains(strings.ToLower(p.NameWithType), query) ||
+		strings.Contains(strings.ToLower(p.NameASCII), query) ||
+		strings.Contains(strings.ToLower(p.NameEn), query) ||
+		strings.Contains(strings.ToLower(p.NameWithTypeEn), query)
 }
 
 // Search methods for Ward
@@ -108,7 +226,11 @@ func (w Ward) MatchesQuery(query string) bool {
 		strings.Contains(strings.ToLower(w.Slug), query) ||
 		strings.Contains(strings.ToLower(w.NameWithType), query) ||
 		strings.Contains(strings.ToLower(w.Path), query) ||
-		strings.Contains(strings.ToLower(w.PathWithType), query)
+		strings.Contains(strings.ToLower(w.PathWithType), query) ||
+		strings.Contains(strings.ToLower(w.NameASCII), query) ||
+		strings.Contains(strings.ToLower(w.NameEn), query) ||
+		strings.Contains(strings.ToLower(w.NameWithTypeEn), query) ||
+		strings.Contains(strings.ToLower(w.pathEn), query)
 }
 
 // Filter methods for Province
@@ -116,6 +238,12 @@ func (p Province) MatchesType(typeFilter string) bool {
 	return typeFilter == "" || p.Type == typeFilter
 }
 
+// MatchesRegion reports whether the province lies in the region, given as
+// either a region or a socio-economic region
+func (p Province) MatchesRegion(region string) bool {
+	return region == "" || p.Region == region || p.EconomicRegion == region
+}
+
 // Filter methods for Ward
 func (w Ward) MatchesType(typeFilter string) bool {
 	return typeFilter == "" || w.Type == typeFilter
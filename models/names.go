@@ -0,0 +1,97 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// LanguageEnglish selects the English names of provinces and wards
+const LanguageEnglish = "en"
+
+// TypeNamesEn maps administrative unit types to the English designation
+// appended to names, e.g. "Ho Chi Minh City" or "Ben Thanh Ward"
+var TypeNamesEn = map[string]string{
+	"thanh-pho": "City",
+	"tinh":      "Province",
+	"phuong":    "Ward",
+	"xa":        "Commune",
+	"dac-khu":   "Special Zone",
+}
+
+// ASCIIName removes Vietnamese diacritics, e.g. "Đà Nẵng" becomes "Da Nang"
+func ASCIIName(name string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	if err != nil {
+		stripped = name
+	}
+	return strings.NewReplacer("Đ", "D", "đ", "d").Replace(stripped)
+}
+
+// nameWithTypeEn appends the English designation of the type to name
+func nameWithTypeEn(name, unitType string) string {
+	if designation, ok := TypeNamesEn[unitType]; ok {
+		return name + " " + designation
+	}
+	return name
+}
+
+// FillNames generates the ASCII and English names that the data file leaves
+// empty. Names present in the data file override the generated ones.
+func (p *Province) FillNames() {
+	if p.NameASCII == "" {
+		p.NameASCII = ASCIIName(p.Name)
+	}
+	if p.NameEn == "" {
+		p.NameEn = p.NameASCII
+	}
+	if p.NameWithTypeEn == "" {
+		p.NameWithTypeEn = nameWithTypeEn(p.NameEn, p.Type)
+	}
+}
+
+// Localized returns the province with Name and NameWithType in lang. Only
+// English names exist; other languages return the province unchanged.
+func (p Province) Localized(lang string) Province {
+	if lang == LanguageEnglish {
+		p.Name = p.NameEn
+		p.NameWithType = p.NameWithTypeEn
+	}
+	return p
+}
+
+// FillNames generates the ASCII and English names that the data file leaves
+// empty, and the English paths from those of the ward's province, which must
+// have been filled first
+func (w *Ward) FillNames(province Province) {
+	if w.NameASCII == "" {
+		w.NameASCII = ASCIIName(w.Name)
+	}
+	if w.NameEn == "" {
+		w.NameEn = w.NameASCII
+	}
+	if w.NameWithTypeEn == "" {
+		w.NameWithTypeEn = nameWithTypeEn(w.NameEn, w.Type)
+	}
+	w.pathEn = w.NameEn
+	w.pathWithTypeEn = w.NameWithTypeEn
+	if province.Code != "" {
+		w.pathEn += ", " + province.NameEn
+		w.pathWithTypeEn += ", " + province.NameWithTypeEn
+	}
+}
+
+// Localized returns the ward with its names and paths in lang. Only English
+// names exist; other languages return the ward unchanged.
+func (w Ward) Localized(lang string) Ward {
+	if lang == LanguageEnglish {
+		w.Name = w.NameEn
+		w.NameWithType = w.NameWithTypeEn
+		w.Path = w.pathEn
+		w.PathWithType = w.pathWithTypeEn
+	}
+	return w
+}
@@ -0,0 +1,62 @@
+package models
+
+import "encoding/json"
+
+// PostalCodeLength is the number of digits of a Vietnamese postal code
+const PostalCodeLength = 5
+
+// postalPrefixLength is the number of leading digits identifying the
+// province, or the former province, of a postal code
+const postalPrefixLength = 2
+
+// PostalCodes lists the postal codes of provinces and wards by code. A
+// province has the codes of every former province merged into it.
+type PostalCodes struct {
+	Provinces map[string][]string `json:"provinces"`
+	Wards     map[string]string   `json:"wards"`
+}
+
+// PostalLookup is the province and wards a postal code belongs to. Wards are
+// only listed when the code is the exact code of a ward.
+type PostalLookup struct {
+	PostalCode string    `json:"postal_code"`
+	Province   *Province `json:"province"`
+	Wards      []Ward    `json:"wards"`
+}
+
+// WardPostal is the postal information of a ward: its own code when known,
+// and the codes of its province
+type WardPostal struct {
+	WardCode            string   `json:"ward_code"`
+	PostalCode          string   `json:"postal_code,omitempty"`
+	ProvincePostalCodes []string `json:"province_postal_codes"`
+}
+
+// IsPostalCode reports whether s is made of exactly five digits
+func IsPostalCode(s string) bool {
+	if len(s) != PostalCodeLength {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// PostalPrefix returns the leading digits of a postal code identifying its
+// province
+func PostalPrefix(code string) string {
+	if len(code) < postalPrefixLength {
+		return code
+	}
+	return code[:postalPrefixLength]
+}
+
+// UnmarshalPostalCodes parses postal.json
+func UnmarshalPostalCodes(data []byte) (PostalCodes, error) {
+	var postal PostalCodes
+	err := json.Unmarshal(data, &postal)
+	return postal, err
+}
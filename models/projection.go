@@ -0,0 +1,180 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Fields selects the JSON fields written for a resource. A nil set selects
+// every field.
+type Fields map[string]bool
+
+// Has reports whether the named field is selected
+func (f Fields) Has(name string) bool {
+	return f == nil || f[name]
+}
+
+// ProvinceFieldNames lists the JSON fields of a province in output order
+var ProvinceFieldNames = []string{"code", "name", "slug", "type", "name_with_type", "name_en", "name_ascii", "name_with_type_en",
+	"region", "economic_region", "phone_codes", "plate_codes", "postal_codes", "centroid"}
+
+// WardFieldNames lists the JSON fields of a ward in output order
+var WardFieldNames = []string{"code", "name", "slug", "type", "name_with_type", "path", "path_with_type", "parent_code", "name_en", "name_ascii", "name_with_type_en", "postal_code", "centroid"}
+
+// ProvinceView is a province serialized with only the selected fields
+type ProvinceView struct {
+	Province
+	Fields Fields `json:"-"`
+}
+
+// MarshalJSON writes the selected fields in the order of ProvinceFieldNames,
+// followed by the alias the province was matched with
+func (v ProvinceView) MarshalJSON() ([]byte, error) {
+	return marshalFields(v.Fields, v.jsonFields(), v.Province.matchedAlias()...)
+}
+
+// Values returns the selected field values in the order of ProvinceFieldNames
+func (v ProvinceView) Values() []interface{} {
+	return selectedValues(v.Fields, v.jsonFields())
+}
+
+func (v ProvinceView) jsonFields() []jsonField {
+	return []jsonField{
+		{"code", v.Code},
+		{"name", v.Name},
+		{"slug", v.Slug},
+		{"type", v.Type},
+		{"name_with_type", v.NameWithType},
+		{"name_en", v.NameEn},
+		{"name_ascii", v.NameASCII},
+		{"name_with_type_en", v.NameWithTypeEn},
+		{"region", v.Region},
+		{"economic_region", v.EconomicRegion},
+		{"phone_codes", v.PhoneCodes},
+		{"plate_codes", v.PlateCodes},
+		{"postal_codes", v.PostalCodes},
+		{"centroid", v.Centroid},
+	}
+}
+
+// ProvinceTreeView is a province view with the views of its wards nested
+type ProvinceTreeView struct {
+	ProvinceView
+	Wards []WardView `json:"wards"`
+}
+
+// MarshalJSON writes the selected province fields followed by the wards
+func (v ProvinceTreeView) MarshalJSON() ([]byte, error) {
+	return marshalFields(v.Fields, v.jsonFields(), jsonField{"wards", v.Wards})
+}
+
+// WardView is a ward serialized with only the selected fields and, when
+// requested, its province embedded
+type WardView struct {
+	Ward
+	Province *Province `json:"province,omitempty"`
+	Fields   Fields    `json:"-"`
+}
+
+// MarshalJSON writes the selected fields in the order of WardFieldNames,
+// followed by the alias the ward was matched with and the embedded province
+func (v WardView) MarshalJSON() ([]byte, error) {
+	embedded := v.Ward.matchedAlias()
+	if v.Province != nil {
+		embedded = append(embedded, jsonField{"province", v.Province})
+	}
+	return marshalFields(v.Fields, v.jsonFields(), embedded...)
+}
+
+// Values returns the selected field values in the order of WardFieldNames
+func (v WardView) Values() []interface{} {
+	return selectedValues(v.Fields, v.jsonFields())
+}
+
+func (v WardView) jsonFields() []jsonField {
+	return []jsonField{
+		{"code", v.Code},
+		{"name", v.Name},
+		{"slug", v.Slug},
+		{"type", v.Type},
+		{"name_with_type", v.NameWithType},
+		{"path", v.Path},
+		{"path_with_type", v.PathWithType},
+		{"parent_code", v.ParentCode},
+		{"name_en", v.NameEn},
+		{"name_ascii", v.NameASCII},
+		{"name_with_type_en", v.NameWithTypeEn},
+		{"postal_code", v.PostalCode},
+		{"centroid", v.Centroid},
+	}
+}
+
+// SelectedFieldNames returns the names in the set, keeping the order of names
+func SelectedFieldNames(names []string, selected Fields) []string {
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		if selected.Has(name) {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// matchedAlias returns the matched_alias field when the province was matched
+// through an alias
+func (p Province) matchedAlias() []jsonField {
+	if p.MatchedAlias == "" {
+		return nil
+	}
+	return []jsonField{{"matched_alias", p.MatchedAlias}}
+}
+
+// matchedAlias returns the matched_alias field when the ward was matched
+// through an alias
+func (w Ward) matchedAlias() []jsonField {
+	if w.MatchedAlias == "" {
+		return nil
+	}
+	return []jsonField{{"matched_alias", w.MatchedAlias}}
+}
+
+type jsonField struct {
+	name  string
+	value interface{}
+}
+
+func selectedValues(selected Fields, fields []jsonField) []interface{} {
+	values := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		if selected.Has(field.name) {
+			values = append(values, field.value)
+		}
+	}
+	return values
+}
+
+// marshalFields encodes the selected fields as a JSON object, followed by the
+// embedded relations, which are always written since they were requested
+// explicitly
+func marshalFields(selected Fields, fields []jsonField, embedded ...jsonField) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range append(fields, embedded...) {
+		if i < len(fields) && !selected.Has(field.name) {
+			continue
+		}
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
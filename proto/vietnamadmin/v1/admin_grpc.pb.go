@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: vietnamadmin/v1/admin.proto
+
+package adminv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AdminService_GetProvince_FullMethodName     = "/vietnamadmin.v1.AdminService/GetProvince"
+	AdminService_ListProvinces_FullMethodName   = "/vietnamadmin.v1.AdminService/ListProvinces"
+	AdminService_GetWard_FullMethodName         = "/vietnamadmin.v1.AdminService/GetWard"
+	AdminService_ListWards_FullMethodName       = "/vietnamadmin.v1.AdminService/ListWards"
+	AdminService_Search_FullMethodName          = "/vietnamadmin.v1.AdminService/Search"
+	AdminService_ValidateAddress_FullMethodName = "/vietnamadmin.v1.AdminService/ValidateAddress"
+	AdminService_ExportProvinces_FullMethodName = "/vietnamadmin.v1.AdminService/ExportProvinces"
+	AdminService_ExportWards_FullMethodName     = "/vietnamadmin.v1.AdminService/ExportWards"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// GetProvince returns a province by code
+	GetProvince(ctx context.Context, in *GetProvinceRequest, opts ...grpc.CallOption) (*Province, error)
+	// ListProvinces returns a page of provinces matching the filters
+	ListProvinces(ctx context.Context, in *ListProvincesRequest, opts ...grpc.CallOption) (*ListProvincesResponse, error)
+	// GetWard returns a ward by code together with its province
+	GetWard(ctx context.Context, in *GetWardRequest, opts ...grpc.CallOption) (*GetWardResponse, error)
+	// ListWards returns a page of wards matching the filters
+	ListWards(ctx context.Context, in *ListWardsRequest, opts ...grpc.CallOption) (*ListWardsResponse, error)
+	// Search looks up provinces and wards by name
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// ValidateAddress checks that a ward belongs to a province
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+	// ExportProvinces streams every province matching the filters
+	ExportProvinces(ctx context.Context, in *ExportProvincesRequest, opts ...grpc.CallOption) (AdminService_ExportProvincesClient, error)
+	// ExportWards streams every ward matching the filters
+	ExportWards(ctx context.Context, in *ExportWardsRequest, opts ...grpc.CallOption) (AdminService_ExportWardsClient, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetProvince(ctx context.Context, in *GetProvinceRequest, opts ...grpc.CallOption) (*Province, error) {
+	out := new(Province)
+	err := c.cc.Invoke(ctx, AdminService_GetProvince_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProvinces(ctx context.Context, in *ListProvincesRequest, opts ...grpc.CallOption) (*ListProvincesResponse, error) {
+	out := new(ListProvincesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProvinces_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWard(ctx context.Context, in *GetWardRequest, opts ...grpc.CallOption) (*GetWardResponse, error) {
+	out := new(GetWardResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetWard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWards(ctx context.Context, in *ListWardsRequest, opts ...grpc.CallOption) (*ListWardsResponse, error) {
+	out := new(ListWardsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, AdminService_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
+	out := new(ValidateAddressResponse)
+	err := c.cc.Invoke(ctx, AdminService_ValidateAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportProvinces(ctx context.Context, in *ExportProvincesRequest, opts ...grpc.CallOption) (AdminService_ExportProvincesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_ExportProvinces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceExportProvincesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_ExportProvincesClient interface {
+	Recv() (*Province, error)
+	grpc.ClientStream
+}
+
+type adminServiceExportProvincesClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceExportProvincesClient) Recv() (*Province, error) {
+	m := new(Province)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) ExportWards(ctx context.Context, in *ExportWardsRequest, opts ...grpc.CallOption) (AdminService_ExportWardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_ExportWards_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceExportWardsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_ExportWardsClient interface {
+	Recv() (*Ward, error)
+	grpc.ClientStream
+}
+
+type adminServiceExportWardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceExportWardsClient) Recv() (*Ward, error) {
+	m := new(Ward)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	// GetProvince returns a province by code
+	GetProvince(context.Context, *GetProvinceRequest) (*Province, error)
+	// ListProvinces returns a page of provinces matching the filters
+	ListProvinces(context.Context, *ListProvincesRequest) (*ListProvincesResponse, error)
+	// GetWard returns a ward by code together with its province
+	GetWard(context.Context, *GetWardRequest) (*GetWardResponse, error)
+	// ListWards returns a page of wards matching the filters
+	ListWards(context.Context, *ListWardsRequest) (*ListWardsResponse, error)
+	// Search looks up provinces and wards by name
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// ValidateAddress checks that a ward belongs to a province
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+	// ExportProvinces streams every province matching the filters
+	ExportProvinces(*ExportProvincesRequest, AdminService_ExportProvincesServer) error
+	// ExportWards streams every ward matching the filters
+	ExportWards(*ExportWardsRequest, AdminService_ExportWardsServer) error
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) GetProvince(context.Context, *GetProvinceRequest) (*Province, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvince not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProvinces(context.Context, *ListProvincesRequest) (*ListProvincesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProvinces not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWard(context.Context, *GetWardRequest) (*GetWardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWard not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWards(context.Context, *ListWardsRequest) (*ListWardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWards not implemented")
+}
+func (UnimplementedAdminServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedAdminServiceServer) ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddress not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportProvinces(*ExportProvincesRequest, AdminService_ExportProvincesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProvinces not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportWards(*ExportWardsRequest, AdminService_ExportWardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportWards not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_GetProvince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProvinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProvince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetProvince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProvince(ctx, req.(*GetProvinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProvinces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvincesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProvinces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProvinces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProvinces(ctx, req.(*ListProvincesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetWard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWard(ctx, req.(*GetWardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWards(ctx, req.(*ListWardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ValidateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ValidateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportProvinces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProvincesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ExportProvinces(m, &adminServiceExportProvincesServer{stream})
+}
+
+type AdminService_ExportProvincesServer interface {
+	Send(*Province) error
+	grpc.ServerStream
+}
+
+type adminServiceExportProvincesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceExportProvincesServer) Send(m *Province) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_ExportWards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWardsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ExportWards(m, &adminServiceExportWardsServer{stream})
+}
+
+type AdminService_ExportWardsServer interface {
+	Send(*Ward) error
+	grpc.ServerStream
+}
+
+type adminServiceExportWardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceExportWardsServer) Send(m *Ward) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vietnamadmin.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProvince",
+			Handler:    _AdminService_GetProvince_Handler,
+		},
+		{
+			MethodName: "ListProvinces",
+			Handler:    _AdminService_ListProvinces_Handler,
+		},
+		{
+			MethodName: "GetWard",
+			Handler:    _AdminService_GetWard_Handler,
+		},
+		{
+			MethodName: "ListWards",
+			Handler:    _AdminService_ListWards_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _AdminService_Search_Handler,
+		},
+		{
+			MethodName: "ValidateAddress",
+			Handler:    _AdminService_ValidateAddress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportProvinces",
+			Handler:       _AdminService_ExportProvinces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportWards",
+			Handler:       _AdminService_ExportWards_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "vietnamadmin/v1/admin.proto",
+}
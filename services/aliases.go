@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/tracing"
+)
+
+// ErrUnknownUnit is returned when aliases are set for a code that is not loaded
+var ErrUnknownUnit = errors.New("unknown administrative unit")
+
+// Administrative unit kinds that carry aliases
+const (
+	AliasProvinces = "provinces"
+	AliasWards     = "wards"
+)
+
+// aliasesFile is the optional alias dictionary of the data directory
+const aliasesFile = "aliases.json"
+
+// aliasIndex maps normalized aliases to the codes carrying them and the alias
+// as written in the dictionary
+type aliasIndex map[string]map[string]string
+
+// newAliasIndex indexes the aliases of codes present in known, dropping and
+// logging the others
+func newAliasIndex(ctx context.Context, kind string, aliases map[string][]string, known func(string) bool) aliasIndex {
+	index := aliasIndex{}
+	for code, names := range aliases {
+		if !known(code) {
+			logging.FromContext(ctx).Warn("ignoring aliases of unknown code", "kind", kind, "code", code)
+			continue
+		}
+		for _, name := range names {
+			key := models.AliasKey(name)
+			if key == "" {
+				continue
+			}
+			if index[key] == nil {
+				index[key] = map[string]string{}
+			}
+			// Spellings of the same alias are reported as the first one
+			if _, ok := index[key][code]; !ok {
+				index[key][code] = name
+			}
+		}
+	}
+	return index
+}
+
+// readAliases reads the alias dictionary. A missing file is an empty
+// dictionary, so aliases are optional.
+func (ds *DataService) readAliases() ([]byte, models.Aliases, error) {
+	data, err := ds.readOptionalFile(aliasesFile)
+	if err != nil || data == nil {
+		return nil, models.Aliases{}, err
+	}
+	aliases, err := models.UnmarshalAliases(data)
+	if err != nil {
+		return nil, models.Aliases{}, fmt.Errorf("failed to parse %s: %w", aliasesFile, err)
+	}
+	return data, aliases, nil
+}
+
+// GetAliases returns a copy of the alias dictionary
+func (ds *DataService) GetAliases() models.Aliases {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.aliases.Clone()
+}
+
+// SetAliases replaces the aliases of a province or ward, saves the dictionary
+// and reloads the data so that searches and caches reflect the change. An
+// empty list removes the unit's aliases. The aliases are returned as saved,
+// trimmed and without duplicates.
+func (ds *DataService) SetAliases(ctx context.Context, kind, code string, aliases []string) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "DataService.SetAliases",
+		trace.WithAttributes(
+			attribute.String("alias.kind", kind),
+			attribute.String("alias.code", code),
+		))
+	defer span.End()
+
+	// Edits are serialized so that concurrent requests cannot lose each other's changes
+	ds.aliasesMu.Lock()
+	defer ds.aliasesMu.Unlock()
+
+	ds.mu.RLock()
+	dictionary := ds.aliases.Clone()
+	_, provinceExists := ds.provinces[code]
+	_, wardExists := ds.wards[code]
+	ds.mu.RUnlock()
+
+	var target map[string][]string
+	switch {
+	case kind == AliasProvinces && provinceExists:
+		target = dictionary.Provinces
+	case kind == AliasWards && wardExists:
+		target = dictionary.Wards
+	default:
+		return nil, ErrUnknownUnit
+	}
+
+	cleaned := make([]string, 0, len(aliases))
+	seen := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if key := models.AliasKey(alias); key != "" && !seen[key] {
+			seen[key] = true
+			cleaned = append(cleaned, alias)
+		}
+	}
+	if len(cleaned) == 0 {
+		delete(target, code)
+	} else {
+		target[code] = cleaned
+	}
+
+	if err := ds.writeAliases(dictionary); err != nil {
+		return nil, err
+	}
+	return cleaned, ds.load(ctx)
+}
+
+// writeAliases replaces the alias dictionary file atomically
+func (ds *DataService) writeAliases(aliases models.Aliases) error {
+	data, err := json.MarshalIndent(aliases, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(ds.dataPath, aliasesFile+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save %s: %w", aliasesFile, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save %s: %w", aliasesFile, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save %s: %w", aliasesFile, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(ds.dataPath, aliasesFile)); err != nil {
+		return fmt.Errorf("failed to save %s: %w", aliasesFile, err)
+	}
+	return nil
+}
+
+// filterProvinces returns the provinces matching the search and type filter,
+// including those whose alias equals the search; the caller must hold the
+// read lock
+func (ds *DataService) filterProvinces(search, typeFilter string) []models.Province {
+	provinces := ds.provinces.ToSliceWithFilters(search, typeFilter)
+	matches := ds.provinceAliases[models.AliasKey(search)]
+	if search == "" || len(matches) == 0 {
+		return provinces
+	}
+
+	matched := make(map[string]bool, len(provinces))
+	for _, province := range provinces {
+		matched[province.Code] = true
+	}
+	for code, alias := range matches {
+		province := ds.provinces[code]
+		if !matched[code] && province.MatchesType(typeFilter) {
+			province.MatchedAlias = alias
+			provinces = append(provinces, province)
+		}
+	}
+	return provinces
+}
+
+// filterWards returns the wards matching the search and filters, including
+// those whose alias equals the search; the caller must hold the read lock
+func (ds *DataService) filterWards(search, typeFilter, provinceCode string) []models.Ward {
+	wards := ds.wards.ToSliceWithFilters(search, typeFilter, provinceCode)
+	matches := ds.wardAliases[models.AliasKey(search)]
+	if search == "" || len(matches) == 0 {
+		return wards
+	}
+
+	matched := make(map[string]bool, len(wards))
+	for _, ward := range wards {
+		matched[ward.Code] = true
+	}
+	for code, alias := range matches {
+		ward := ds.wards[code]
+		if !matched[code] && ward.MatchesType(typeFilter) && ward.MatchesParentCode(provinceCode) {
+			ward.MatchedAlias = alias
+			wards = append(wards, ward)
+		}
+	}
+	return wards
+}
+
+// newNameIndexes indexes the names of the provinces and wards, with and
+// without type and in Vietnamese and English, the same way as aliases
+func newNameIndexes(ctx context.Context, provinces models.ProvinceData, wards models.WardData) (aliasIndex, aliasIndex) {
+	provinceNames := make(map[string][]string, len(provinces))
+	for code, p := range provinces {
+		provinceNames[code] = []string{p.Name, p.NameWithType, p.NameEn, p.NameWithTypeEn}
+	}
+	wardNames := make(map[string][]string, len(wards))
+	for code, w := range wards {
+		wardNames[code] = []string{w.Name, w.NameWithType, w.NameEn, w.NameWithTypeEn}
+	}
+	known := func(string) bool { return true }
+	return newAliasIndex(ctx, AliasProvinces, provinceNames, known), newAliasIndex(ctx, AliasWards, wardNames, known)
+}
+
+// lookup returns the code carrying key among the codes accepted by inScope,
+// with the alias as written, and the number of such codes
+func (index aliasIndex) lookup(key string, inScope func(code string) bool) (code, alias string, n int) {
+	for c, a := range index[key] {
+		if inScope(c) {
+			code, alias = c, a
+			n++
+		}
+	}
+	return code, alias, n
+}
+
+// resolveName returns the only unit in scope designated by name. Names of
+// units take precedence over aliases, so that an alias cannot shadow a
+// unit's own name; the alias is returned when it designated the unit.
+func resolveName(name string, names, aliases aliasIndex, inScope func(code string) bool) (code, alias string, ok bool) {
+	key := models.AliasKey(name)
+	if key == "" {
+		return "", "", false
+	}
+	if code, _, n := names.lookup(key, inScope); n > 0 {
+		return code, "", n == 1
+	}
+	code, alias, n := aliases.lookup(key, inScope)
+	return code, alias, n == 1
+}
+
+// resolveProvince returns the province of the code or, when code is empty,
+// the province named so; the caller must hold the read lock
+func (ds *DataService) resolveProvince(code, name string) (models.Province, bool) {
+	alias := ""
+	if code == "" {
+		var ok bool
+		if code, alias, ok = resolveName(name, ds.provinceNames, ds.provinceAliases, func(string) bool { return true }); !ok {
+			return models.Province{}, false
+		}
+	}
+	province, ok := ds.provinces[code]
+	province.MatchedAlias = alias
+	return province, ok
+}
+
+// resolveWard returns the ward of the code or, when code is empty, the ward
+// of the province named so. Ward names repeat across provinces, so names are
+// only resolved within the province; the caller must hold the read lock.
+func (ds *DataService) resolveWard(provinceCode, code, name string) (models.Ward, bool) {
+	alias := ""
+	if code == "" {
+		inProvince := func(code string) bool { return ds.wards[code].ParentCode == provinceCode }
+		var ok bool
+		if code, alias, ok = resolveName(name, ds.wardNames, ds.wardAliases, inProvince); !ok {
+			return models.Ward{}, false
+		}
+	}
+	ward, ok := ds.wards[code]
+	ward.MatchedAlias = alias
+	return ward, ok
+}
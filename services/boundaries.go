@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"vietnam-admin-api/geo"
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/tracing"
+)
+
+var (
+	// ErrBoundariesUnavailable is returned by reverse geocoding when no ward
+	// boundaries are loaded
+	ErrBoundariesUnavailable = errors.New("ward boundaries are not loaded")
+	// ErrLocationNotFound is returned when no ward boundary contains a point
+	ErrLocationNotFound = errors.New("no ward contains the location")
+	// ErrNoBoundary is returned for wards without a boundary
+	ErrNoBoundary = errors.New("ward has no boundary")
+	// ErrUnknownSimplifyLevel is returned for levels not in SimplifyLevels
+	ErrUnknownSimplifyLevel = errors.New("unknown simplification level")
+)
+
+// boundariesFile is the optional GeoJSON file of ward boundaries
+const boundariesFile = "boundaries.geojson"
+
+// SimplifyLevels lists the boundary simplification levels, from none to
+// the coarsest
+var SimplifyLevels = []string{"none", "low", "medium", "high"}
+
+// simplifyTolerances are the Douglas-Peucker tolerances of the levels in
+// degrees, about 5 m, 20 m and 110 m
+var simplifyTolerances = map[string]float64{
+	"none":   0,
+	"low":    0.00005,
+	"medium": 0.0002,
+	"high":   0.001,
+}
+
+// boundaryIndex holds the ward boundaries and the R-tree of their bounding
+// boxes
+type boundaryIndex struct {
+	shapes map[string]geo.MultiPolygon
+	tree   *geo.RTree
+}
+
+// readBoundaries reads and indexes the ward boundaries. A missing file means
+// no boundaries are known. Features without a known ward code or with an
+// unusable geometry are dropped and logged.
+func (ds *DataService) readBoundaries(ctx context.Context, wards models.WardData) ([]byte, boundaryIndex, error) {
+	index := boundaryIndex{shapes: map[string]geo.MultiPolygon{}}
+	data, err := ds.readOptionalFile(boundariesFile)
+	if err != nil || data == nil {
+		index.tree = geo.NewRTree(nil)
+		return nil, index, err
+	}
+
+	features, skipped, err := geo.ParseFeatureCollection(data)
+	if err != nil {
+		return nil, index, fmt.Errorf("failed to parse %s: %w", boundariesFile, err)
+	}
+	logger := logging.FromContext(ctx)
+	for i, err := range skipped {
+		logger.Warn("ignoring boundary feature", "index", i, "error", err)
+	}
+
+	entries := make([]geo.Entry, 0, len(features))
+	for _, feature := range features {
+		code := featureCode(feature.Properties)
+		if _, ok := wards[code]; !ok {
+			logger.Warn("ignoring boundary of unknown ward", "ward", code)
+			continue
+		}
+		if _, ok := index.shapes[code]; ok {
+			logger.Warn("ignoring duplicate boundary of ward", "ward", code)
+			continue
+		}
+		index.shapes[code] = feature.Geometry
+		entries = append(entries, geo.Entry{ID: code, Box: feature.Geometry.Bounds()})
+	}
+	index.tree = geo.NewRTree(entries)
+	return data, index, nil
+}
+
+// featureCode reads the ward code property of a feature, written as a
+// string or a number
+func featureCode(properties map[string]interface{}) string {
+	switch code := properties["code"].(type) {
+	case string:
+		return code
+	case float64:
+		return strconv.FormatFloat(code, 'f', -1, 64)
+	}
+	return ""
+}
+
+// ReverseGeocode returns the ward whose boundary contains the point, with its
+// province
+func (ds *DataService) ReverseGeocode(ctx context.Context, lat, lng float64) (*models.ReverseGeocode, error) {
+	_, span := tracing.Start(ctx, "DataService.ReverseGeocode",
+		trace.WithAttributes(
+			attribute.Float64("geo.lat", lat),
+			attribute.Float64("geo.lng", lng),
+		))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if ds.boundaries.tree.Len() == 0 {
+		return nil, ErrBoundariesUnavailable
+	}
+	point := geo.Point{Lat: lat, Lng: lng}
+	candidates := ds.boundaries.tree.Search(point)
+	span.SetAttributes(attribute.Int("geo.candidates", len(candidates)))
+	for _, code := range candidates {
+		if !ds.boundaries.shapes[code].Contains(point) {
+			continue
+		}
+		result := &models.ReverseGeocode{Ward: ds.wards[code]}
+		if province, ok := ds.provinces[result.Ward.ParentCode]; ok {
+			result.Province = &province
+		}
+		return result, nil
+	}
+	return nil, ErrLocationNotFound
+}
+
+// GetWardBoundary returns the boundary of a ward as a GeoJSON Feature,
+// simplified to one of SimplifyLevels
+func (ds *DataService) GetWardBoundary(ctx context.Context, code, level string) (*models.BoundaryFeature, error) {
+	_, span := tracing.Start(ctx, "DataService.GetWardBoundary",
+		trace.WithAttributes(
+			attribute.String("ward.code", code),
+			attribute.String("geo.simplify", level),
+		))
+	defer span.End()
+
+	tolerance, ok := simplifyTolerances[level]
+	if !ok {
+		return nil, ErrUnknownSimplifyLevel
+	}
+
+	ds.mu.RLock()
+	ward, exists := ds.wards[code]
+	shape, hasShape := ds.boundaries.shapes[code]
+	ds.mu.RUnlock()
+	if !exists {
+		return nil, ErrUnknownUnit
+	}
+	if !hasShape {
+		return nil, ErrNoBoundary
+	}
+
+	// Simplification runs outside the lock as shapes are never modified
+	simplified := shape.Simplify(tolerance)
+	span.SetAttributes(attribute.Int("geo.points", simplified.Points()))
+	return &models.BoundaryFeature{
+		Type: "Feature",
+		Properties: models.BoundaryProperties{
+			Code:         ward.Code,
+			Name:         ward.Name,
+			NameWithType: ward.NameWithType,
+			ParentCode:   ward.ParentCode,
+			Simplify:     level,
+			Points:       simplified.Points(),
+		},
+		Geometry: models.BoundaryGeometry{Type: "MultiPolygon", Coordinates: simplified.Coordinates()},
+	}, nil
+}
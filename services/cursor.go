@@ -0,0 +1,117 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+var (
+	// ErrInvalidCursor is returned for cursors that cannot be decoded or do
+	// not match the requested sort order
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrStaleCursor is returned for cursors issued for a dataset version
+	// that has since been replaced by a reload
+	ErrStaleCursor = errors.New("cursor refers to a previous version of the data")
+)
+
+// cursorVersionLength is the number of checksum characters kept in cursors
+const cursorVersionLength = 16
+
+// Cursor is the decoded form of an opaque pagination cursor. It records the
+// sort key and code of the last item returned, the sort order it applies to
+// and the dataset version it was issued for.
+type Cursor struct {
+	Version string `json:"v"`
+	Sort    string `json:"s"`
+	Key     string `json:"k"`
+	Code    string `json:"c"`
+}
+
+// Encode returns the opaque string form of the cursor
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode
+func DecodeCursor(s string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Version == "" || c.Code == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Page selects a window of a result set sorted by Sort, either by offset or,
+// when After is set, by position after a cursor
+type Page struct {
+	Limit  int
+	Offset int
+	Sort   SortOrder
+	After  *Cursor
+}
+
+// PageResult describes the window returned for a Page
+type PageResult struct {
+	Total      int
+	Offset     int
+	NextCursor string
+}
+
+// sortKeyFunc returns the sort key and the unique code of an item. Items must
+// be ordered by sortItems with the same keys for keyset pagination to be
+// consistent.
+type sortKeyFunc[T any] func(item T) (key, code string)
+
+// paginate cuts the page out of sorted items and builds the cursor for the
+// next page. The caller must hold the read lock so version matches items.
+func paginate[T any](items []T, page Page, version string, keyOf sortKeyFunc[T]) ([]T, PageResult, error) {
+	version = shortVersion(version)
+	sortName := page.Sort.String()
+	result := PageResult{Total: len(items)}
+
+	start := page.Offset
+	if page.After != nil {
+		if page.After.Version != version {
+			return nil, result, ErrStaleCursor
+		}
+		if page.After.Sort != sortName {
+			return nil, result, ErrInvalidCursor
+		}
+		// First item ordered strictly after the cursor position
+		start = sort.Search(len(items), func(i int) bool {
+			key, code := keyOf(items[i])
+			return sortsBefore(page.After.Key, page.After.Code, key, code, page.Sort.Desc)
+		})
+	}
+
+	if start > len(items) {
+		start = len(items)
+	}
+	end := start + page.Limit
+	if end > len(items) {
+		end = len(items)
+	}
+	result.Offset = start
+
+	if end < len(items) && end > start {
+		key, code := keyOf(items[end-1])
+		result.NextCursor = Cursor{Version: version, Sort: sortName, Key: key, Code: code}.Encode()
+	}
+	return items[start:end], result, nil
+}
+
+func shortVersion(checksum string) string {
+	if len(checksum) > cursorVersionLength {
+		return checksum[:cursorVersionLength]
+	}
+	return checksum
+}
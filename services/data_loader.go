@@ -1,15 +1,26 @@
 package services
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"sort"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"vietnam-admin-api/geo"
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/metrics"
 	"vietnam-admin-api/models"
+	"vietnam-admin-api/tracing"
 )
 
 // DataService handles loading and accessing Vietnamese administrative data
@@ -17,8 +28,40 @@ type DataService struct {
 	provinces models.ProvinceData
 	wards     models.WardData
 	mu        sync.RWMutex
-	loadTime  time.Time
-	dataPath  string
+
+	// Vietnamese collation keys of names, by code
+	provinceNameKeys map[string]string
+	wardNameKeys     map[string]string
+
+	// Alias dictionary and its indexes by normalized alias
+	aliases         models.Aliases
+	provinceAliases aliasIndex
+	wardAliases     aliasIndex
+	aliasesMu       sync.Mutex
+
+	// Names of the units, indexed like aliases to resolve addresses
+	provinceNames aliasIndex
+	wardNames     aliasIndex
+
+	// Postal codes by province prefix and exact ward code
+	postal postalIndex
+
+	// Spatial index of the ward centroids
+	wardCentroids *geo.KDTree
+
+	// Ward boundaries and their R-tree
+	boundaries boundaryIndex
+
+	loadTime time.Time
+	checksum string
+	dataPath string
+
+	// loadMu serializes loads, so that a slow load cannot replace the data
+	// of one that read the files after it
+	loadMu sync.Mutex
+
+	hooksMu     sync.Mutex
+	reloadHooks []func()
 }
 
 // NewDataService creates a new DataService instance
@@ -30,52 +73,267 @@ func NewDataService(dataPath string) *DataService {
 
 // LoadData loads JSON data from files into memory
 func (ds *DataService) LoadData() error {
-	ds.mu.Lock()
-	defer ds.mu.Unlock()
+	return ds.load(context.Background())
+}
+
+// OnReload registers a function called after every successful data load,
+// for example to invalidate caches derived from the previous data
+func (ds *DataService) OnReload(hook func()) {
+	ds.hooksMu.Lock()
+	defer ds.hooksMu.Unlock()
+	ds.reloadHooks = append(ds.reloadHooks, hook)
+}
+
+// load replaces the loaded data and runs the reload hooks on success
+func (ds *DataService) load(ctx context.Context) error {
+	if err := ds.replaceData(ctx); err != nil {
+		return err
+	}
 
-	log.Println("Loading Vietnamese administrative data...")
+	ds.hooksMu.Lock()
+	hooks := append([]func(){}, ds.reloadHooks...)
+	ds.hooksMu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+	return nil
+}
+
+// replaceData loads the data files, logging with the request scope carried by ctx
+func (ds *DataService) replaceData(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "DataService.LoadData",
+		trace.WithAttributes(attribute.String("data.path", ds.dataPath)))
+	defer span.End()
+
+	ds.loadMu.Lock()
+	defer ds.loadMu.Unlock()
+
+	logger := logging.FromContext(ctx)
+	logger.Info("loading administrative data", "data_path", ds.dataPath)
 	startTime := time.Now()
 
+	data, err := ds.readFiles(ctx)
+	if err != nil {
+		metrics.DataReloadDuration.Observe(time.Since(startTime).Seconds())
+		metrics.DataReloads.Inc("failure")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	// Files are read and indexed without the lock, so requests are only
+	// held back while the new data is swapped in
+	ds.mu.Lock()
+	ds.provinces = data.provinces
+	ds.wards = data.wards
+	ds.provinceNameKeys = data.provinceNameKeys
+	ds.wardNameKeys = data.wardNameKeys
+	ds.aliases = data.aliases
+	ds.provinceAliases = data.provinceAliases
+	ds.wardAliases = data.wardAliases
+	ds.provinceNames = data.provinceNames
+	ds.wardNames = data.wardNames
+	ds.postal = data.postal
+	ds.wardCentroids = data.wardCentroids
+	ds.boundaries = data.boundaries
+	ds.loadTime = time.Now()
+	ds.checksum = data.checksum
+	loadTime := ds.loadTime
+	ds.mu.Unlock()
+
+	metrics.DataReloadDuration.Observe(time.Since(startTime).Seconds())
+	span.SetAttributes(
+		attribute.Int("data.provinces", len(data.provinces)),
+		attribute.Int("data.wards", len(data.wards)),
+	)
+
+	metrics.DataReloads.Inc("success")
+	metrics.DatasetSize.Set(float64(len(data.provinces)), "province")
+	metrics.DatasetSize.Set(float64(len(data.wards)), "ward")
+	metrics.LastReloadTimestamp.Set(float64(loadTime.Unix()))
+
+	logger.Info("data loaded",
+		"duration_ms", time.Since(startTime).Milliseconds(),
+		"provinces", len(data.provinces),
+		"wards", len(data.wards),
+	)
+
+	return nil
+}
+
+// loadedData is the content of the data files with its indexes, built by
+// readFiles before it replaces the fields of the DataService
+type loadedData struct {
+	provinces        models.ProvinceData
+	wards            models.WardData
+	provinceNameKeys map[string]string
+	wardNameKeys     map[string]string
+	aliases          models.Aliases
+	provinceAliases  aliasIndex
+	wardAliases      aliasIndex
+	provinceNames    aliasIndex
+	wardNames        aliasIndex
+	postal           postalIndex
+	wardCentroids    *geo.KDTree
+	boundaries       boundaryIndex
+	checksum         string
+}
+
+// readFiles reads, parses and indexes the data files. It does not use the
+// loaded data, so the caller does not need to hold the lock.
+func (ds *DataService) readFiles(ctx context.Context) (*loadedData, error) {
 	// Load provinces
+	_, span := tracing.Start(ctx, "DataService.loadProvinces")
 	provinceFile := filepath.Join(ds.dataPath, "province.json")
 	provinceData, err := os.ReadFile(provinceFile)
 	if err != nil {
-		return fmt.Errorf("failed to read province.json: %w", err)
+		span.End()
+		return nil, fmt.Errorf("failed to read province.json: %w", err)
 	}
 
 	provinces, err := models.UnmarshalProvinceData(provinceData)
+	span.End()
 	if err != nil {
-		return fmt.Errorf("failed to parse province.json: %w", err)
+		return nil, fmt.Errorf("failed to parse province.json: %w", err)
 	}
 
 	// Load wards
+	_, span = tracing.Start(ctx, "DataService.loadWards")
 	wardFile := filepath.Join(ds.dataPath, "ward.json")
 	wardData, err := os.ReadFile(wardFile)
 	if err != nil {
-		return fmt.Errorf("failed to read ward.json: %w", err)
+		span.End()
+		return nil, fmt.Errorf("failed to read ward.json: %w", err)
 	}
 
 	wards, err := models.UnmarshalWardData(wardData)
+	span.End()
 	if err != nil {
-		return fmt.Errorf("failed to parse ward.json: %w", err)
+		return nil, fmt.Errorf("failed to parse ward.json: %w", err)
 	}
 
-	// Set loaded data
-	ds.provinces = provinces
-	ds.wards = wards
-	ds.loadTime = time.Now()
+	// English and ASCII names not given in the data files are generated
+	for code, province := range provinces {
+		province.FillNames()
+		provinces[code] = province
+	}
+	for code, ward := range wards {
+		ward.FillNames(provinces[ward.ParentCode])
+		wards[code] = ward
+	}
+
+	aliasData, aliases, err := ds.readAliases()
+	if err != nil {
+		return nil, err
+	}
+	provinceAliases := newAliasIndex(ctx, AliasProvinces, aliases.Provinces, func(code string) bool {
+		_, ok := provinces[code]
+		return ok
+	})
+	wardAliases := newAliasIndex(ctx, AliasWards, aliases.Wards, func(code string) bool {
+		_, ok := wards[code]
+		return ok
+	})
+	provinceNames, wardNames := newNameIndexes(ctx, provinces, wards)
+
+	postalData, postalCodes, err := ds.readPostalCodes()
+	if err != nil {
+		return nil, err
+	}
+	postal := applyPostalCodes(ctx, postalCodes, provinces, wards)
 
-	loadDuration := time.Since(startTime)
-	log.Printf("Data loaded successfully in %v - Provinces: %d, Wards: %d",
-		loadDuration, len(ds.provinces), len(ds.wards))
+	_, span = tracing.Start(ctx, "DataService.indexCentroids")
+	centroidData, centroids, err := ds.readCentroids()
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+	wardCentroids := applyCentroids(ctx, centroids, provinces, wards)
+	span.SetAttributes(attribute.Int("data.ward_centroids", wardCentroids.Len()))
+	span.End()
 
-	return nil
+	_, span = tracing.Start(ctx, "DataService.indexBoundaries")
+	boundaryData, boundaries, err := ds.readBoundaries(ctx, wards)
+	if err != nil {
+		span.End()
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("data.ward_boundaries", boundaries.tree.Len()))
+	span.End()
+
+	// The checksum identifies the dataset version for caches and ETags.
+	// Aliases, postal codes, centroids and boundaries change responses, so
+	// they are part of it.
+	hash := sha256.New()
+	hash.Write(provinceData)
+	hash.Write(wardData)
+	hash.Write(aliasData)
+	hash.Write(postalData)
+	hash.Write(centroidData)
+	hash.Write(boundaryData)
+
+	// Code lists are never null so that clients can iterate them
+	for code, province := range provinces {
+		if province.PhoneCodes == nil {
+			province.PhoneCodes = []string{}
+		}
+		if province.PlateCodes == nil {
+			province.PlateCodes = []string{}
+		}
+		provinces[code] = province
+	}
+
+	// Collation keys are computed once so sorting compares plain strings
+	collation := newCollationKeys()
+	provinceNameKeys := make(map[string]string, len(provinces))
+	for code, province := range provinces {
+		provinceNameKeys[code] = collation.key(province.Name)
+	}
+	wardNameKeys := make(map[string]string, len(wards))
+	for code, ward := range wards {
+		wardNameKeys[code] = collation.key(ward.Name)
+	}
+
+	return &loadedData{
+		provinces:        provinces,
+		wards:            wards,
+		provinceNameKeys: provinceNameKeys,
+		wardNameKeys:     wardNameKeys,
+		aliases:          aliases,
+		provinceAliases:  provinceAliases,
+		wardAliases:      wardAliases,
+		provinceNames:    provinceNames,
+		wardNames:        wardNames,
+		postal:           postal,
+		wardCentroids:    wardCentroids,
+		boundaries:       boundaries,
+		checksum:         hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// readOptionalFile reads a file of the data directory that complements
+// province.json and ward.json, returning nil when it does not exist.
+//
+// Optional files are keyed by province and ward codes, so they can fall
+// behind the main files. Their readers drop and log entries of unknown codes
+// or with invalid values instead of failing, so that a stale entry cannot
+// prevent a reload; only a file that cannot be read or parsed fails it.
+func (ds *DataService) readOptionalFile(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(ds.dataPath, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return data, nil
 }
 
 // ReloadData reloads data from JSON files
-func (ds *DataService) ReloadData() error {
-	log.Println("Reloading data...")
-	return ds.LoadData()
+func (ds *DataService) ReloadData(ctx context.Context) error {
+	logging.FromContext(ctx).Info("reloading data")
+	return ds.load(ctx)
 }
 
 // GetLoadTime returns when data was last loaded
@@ -85,6 +343,14 @@ func (ds *DataService) GetLoadTime() time.Time {
 	return ds.loadTime
 }
 
+// GetChecksum returns the SHA-256 checksum of the loaded data files. It
+// changes whenever a reload picks up different data.
+func (ds *DataService) GetChecksum() string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	return ds.checksum
+}
+
 // IsDataLoaded checks if data has been loaded
 func (ds *DataService) IsDataLoaded() bool {
 	ds.mu.RLock()
@@ -97,14 +363,19 @@ func (ds *DataService) GetDataStats() map[string]interface{} {
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
+	// Avoid IsDataLoaded here: re-acquiring the read lock can deadlock
+	// against a pending reload
+	isLoaded := len(ds.provinces) > 0 && len(ds.wards) > 0
+
 	stats := map[string]interface{}{
 		"provinces": len(ds.provinces),
 		"wards":     len(ds.wards),
 		"load_time": ds.loadTime,
-		"is_loaded": ds.IsDataLoaded(),
+		"checksum":  ds.checksum,
+		"is_loaded": isLoaded,
 	}
 
-	if ds.IsDataLoaded() {
+	if isLoaded {
 		// Count by province types
 		provinceTypes := make(map[string]int)
 		for _, province := range ds.provinces {
@@ -132,14 +403,16 @@ func (ds *DataService) GetAllProvinces() []models.Province {
 	defer ds.mu.RUnlock()
 
 	provinces := ds.provinces.ToSlice()
-	sort.Slice(provinces, func(i, j int) bool {
-		return provinces[i].Name < provinces[j].Name
-	})
+	sortItems(provinces, false, ds.provinceSortKey(SortByName))
 	return provinces
 }
 
 // GetProvince returns a province by code
-func (ds *DataService) GetProvince(code string) (*models.Province, error) {
+func (ds *DataService) GetProvince(ctx context.Context, code string) (*models.Province, error) {
+	_, span := tracing.Start(ctx, "DataService.GetProvince",
+		trace.WithAttributes(attribute.String("province.code", code)))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
@@ -150,22 +423,82 @@ func (ds *DataService) GetProvince(code string) (*models.Province, error) {
 	return &province, nil
 }
 
-// SearchProvinces searches provinces with filters and pagination
-func (ds *DataService) SearchProvinces(search, typeFilter string, limit, offset int) ([]models.Province, int) {
+// GetProvincesByCode returns the provinces with the given codes, keyed by
+// code. Unknown codes are skipped.
+func (ds *DataService) GetProvincesByCode(ctx context.Context, codes []string) map[string]models.Province {
+	_, span := tracing.Start(ctx, "DataService.GetProvincesByCode",
+		trace.WithAttributes(attribute.Int("province.codes", len(codes))))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
-	// Filter provinces
-	filteredProvinces := ds.provinces.ToSliceWithFilters(search, typeFilter)
+	provinces := make(map[string]models.Province, len(codes))
+	for _, code := range codes {
+		if province, exists := ds.provinces[code]; exists {
+			provinces[code] = province
+		}
+	}
+	return provinces
+}
 
-	// Sort by name
-	sort.Slice(filteredProvinces, func(i, j int) bool {
-		return filteredProvinces[i].Name < filteredProvinces[j].Name
-	})
+// SearchProvinces searches provinces with filters and pagination
+func (ds *DataService) SearchProvinces(ctx context.Context, search, typeFilter, region string, page Page) ([]models.Province, PageResult, error) {
+	_, span := tracing.Start(ctx, "DataService.SearchProvinces",
+		trace.WithAttributes(
+			attribute.String("search.query", search),
+			attribute.String("search.type", typeFilter),
+			attribute.String("search.region", region),
+		))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	// Filter and sort, with code as the tie-breaker so that pages are stable
+	filteredProvinces := provincesInRegion(ds.filterProvinces(search, typeFilter), region)
+	keyOf := ds.provinceSortKey(page.Sort.Field)
+	sortItems(filteredProvinces, page.Sort.Desc, keyOf)
 
 	// Apply pagination
-	paginatedData, total := models.PaginateSlice(filteredProvinces, limit, offset)
-	return paginatedData.([]models.Province), total
+	provinces, result, err := paginate(filteredProvinces, page, ds.checksum, keyOf)
+	span.SetAttributes(attribute.Int("search.total", result.Total))
+	return provinces, result, err
+}
+
+// provinceSortKey returns the sort key for a province field; the caller must
+// hold the read lock. Sorting provinces by province is sorting by name.
+func (ds *DataService) provinceSortKey(field string) sortKeyFunc[models.Province] {
+	switch field {
+	case SortByCode:
+		return func(p models.Province) (string, string) { return p.Code, p.Code }
+	case SortByType:
+		return func(p models.Province) (string, string) {
+			return p.Type + sortKeySeparator + ds.provinceNameKeys[p.Code], p.Code
+		}
+	default:
+		return func(p models.Province) (string, string) { return ds.provinceNameKeys[p.Code], p.Code }
+	}
+}
+
+// ListProvinces returns every province matching the filters in the given
+// order, together with the checksum of the data they were read from
+func (ds *DataService) ListProvinces(ctx context.Context, search, typeFilter, region string, order SortOrder) ([]models.Province, string) {
+	_, span := tracing.Start(ctx, "DataService.ListProvinces",
+		trace.WithAttributes(
+			attribute.String("search.query", search),
+			attribute.String("search.type", typeFilter),
+			attribute.String("search.region", region),
+		))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	provinces := provincesInRegion(ds.filterProvinces(search, typeFilter), region)
+	sortItems(provinces, order.Desc, ds.provinceSortKey(order.Field))
+	span.SetAttributes(attribute.Int("search.total", len(provinces)))
+	return provinces, ds.checksum
 }
 
 // GetProvinceTypes returns all unique province types
@@ -194,14 +527,16 @@ func (ds *DataService) GetAllWards() []models.Ward {
 	defer ds.mu.RUnlock()
 
 	wards := ds.wards.ToSlice()
-	sort.Slice(wards, func(i, j int) bool {
-		return wards[i].Name < wards[j].Name
-	})
+	sortItems(wards, false, ds.wardSortKey(SortByName))
 	return wards
 }
 
 // GetWard returns a ward by code
-func (ds *DataService) GetWard(code string) (*models.Ward, error) {
+func (ds *DataService) GetWard(ctx context.Context, code string) (*models.Ward, error) {
+	_, span := tracing.Start(ctx, "DataService.GetWard",
+		trace.WithAttributes(attribute.String("ward.code", code)))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
@@ -213,33 +548,112 @@ func (ds *DataService) GetWard(code string) (*models.Ward, error) {
 }
 
 // GetWardsByProvince returns wards belonging to a specific province
-func (ds *DataService) GetWardsByProvince(provinceCode string) []models.Ward {
+func (ds *DataService) GetWardsByProvince(ctx context.Context, provinceCode string) []models.Ward {
+	_, span := tracing.Start(ctx, "DataService.GetWardsByProvince",
+		trace.WithAttributes(attribute.String("province.code", provinceCode)))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
 	wards := ds.wards.ToSliceWithFilters("", "", provinceCode)
-	sort.Slice(wards, func(i, j int) bool {
-		return wards[i].Name < wards[j].Name
-	})
+	sortItems(wards, false, ds.wardSortKey(SortByName))
 	return wards
 }
 
 // SearchWards searches wards with filters and pagination
-func (ds *DataService) SearchWards(search, typeFilter, provinceCode string, limit, offset int) ([]models.Ward, int) {
+func (ds *DataService) SearchWards(ctx context.Context, search, typeFilter, provinceCode, region string, page Page) ([]models.Ward, PageResult, error) {
+	_, span := tracing.Start(ctx, "DataService.SearchWards",
+		trace.WithAttributes(
+			attribute.String("search.query", search),
+			attribute.String("search.type", typeFilter),
+			attribute.String("province.code", provinceCode),
+			attribute.String("search.region", region),
+		))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
-	// Filter wards
-	filteredWards := ds.wards.ToSliceWithFilters(search, typeFilter, provinceCode)
-
-	// Sort by name
-	sort.Slice(filteredWards, func(i, j int) bool {
-		return filteredWards[i].Name < filteredWards[j].Name
-	})
+	// Filter and sort, with code as the tie-breaker so that pages are stable
+	filteredWards := ds.wardsInRegion(ds.filterWards(search, typeFilter, provinceCode), region)
+	keyOf := ds.wardSortKey(page.Sort.Field)
+	sortItems(filteredWards, page.Sort.Desc, keyOf)
 
 	// Apply pagination
-	paginatedData, total := models.PaginateSlice(filteredWards, limit, offset)
-	return paginatedData.([]models.Ward), total
+	wards, result, err := paginate(filteredWards, page, ds.checksum, keyOf)
+	span.SetAttributes(attribute.Int("search.total", result.Total))
+	return wards, result, err
+}
+
+// wardSortKey returns the sort key for a ward field; the caller must hold the
+// read lock. Type and province orders fall back to the ward name.
+func (ds *DataService) wardSortKey(field string) sortKeyFunc[models.Ward] {
+	switch field {
+	case SortByCode:
+		return func(w models.Ward) (string, string) { return w.Code, w.Code }
+	case SortByType:
+		return func(w models.Ward) (string, string) {
+			return w.Type + sortKeySeparator + ds.wardNameKeys[w.Code], w.Code
+		}
+	case SortByProvince:
+		return func(w models.Ward) (string, string) {
+			return ds.provinceNameKeys[w.ParentCode] + sortKeySeparator + ds.wardNameKeys[w.Code], w.Code
+		}
+	default:
+		return func(w models.Ward) (string, string) { return ds.wardNameKeys[w.Code], w.Code }
+	}
+}
+
+// ListWards returns every ward matching the filters in the given order,
+// together with the checksum of the data they were read from. Unlike
+// SearchWards the result is not paginated, for bulk exports.
+func (ds *DataService) ListWards(ctx context.Context, search, typeFilter, provinceCode, region string, order SortOrder) ([]models.Ward, string) {
+	_, span := tracing.Start(ctx, "DataService.ListWards",
+		trace.WithAttributes(
+			attribute.String("search.query", search),
+			attribute.String("search.type", typeFilter),
+			attribute.String("province.code", provinceCode),
+			attribute.String("search.region", region),
+		))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	wards := ds.wardsInRegion(ds.filterWards(search, typeFilter, provinceCode), region)
+	sortItems(wards, order.Desc, ds.wardSortKey(order.Field))
+	span.SetAttributes(attribute.Int("search.total", len(wards)))
+	return wards, ds.checksum
+}
+
+// GetTree returns every province with its wards, both sorted by name,
+// together with the checksum of the data they were read from
+func (ds *DataService) GetTree(ctx context.Context) ([]models.ProvinceWithWards, string) {
+	_, span := tracing.Start(ctx, "DataService.GetTree")
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	provinces := ds.provinces.ToSlice()
+	sortItems(provinces, false, ds.provinceSortKey(SortByName))
+
+	wardsByProvince := make(map[string][]models.Ward, len(provinces))
+	for _, ward := range ds.wards {
+		wardsByProvince[ward.ParentCode] = append(wardsByProvince[ward.ParentCode], ward)
+	}
+
+	tree := make([]models.ProvinceWithWards, len(provinces))
+	for i, province := range provinces {
+		wards := wardsByProvince[province.Code]
+		sortItems(wards, false, ds.wardSortKey(SortByName))
+		if wards == nil {
+			wards = []models.Ward{}
+		}
+		tree[i] = models.ProvinceWithWards{Province: province, Wards: wards}
+	}
+	return tree, ds.checksum
 }
 
 // GetWardTypes returns all unique ward types
@@ -263,7 +677,14 @@ func (ds *DataService) GetWardTypes() []string {
 // Search Methods
 
 // GlobalSearch performs a global search across provinces and wards
-func (ds *DataService) GlobalSearch(query string, entity string, limit int) models.SearchData {
+func (ds *DataService) GlobalSearch(ctx context.Context, query string, entity string, limit int) models.SearchData {
+	_, span := tracing.Start(ctx, "DataService.GlobalSearch",
+		trace.WithAttributes(
+			attribute.String("search.query", query),
+			attribute.String("search.entity", entity),
+		))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
@@ -273,10 +694,8 @@ func (ds *DataService) GlobalSearch(query string, entity string, limit int) mode
 	}
 
 	if entity == "all" || entity == "province" {
-		provinces := ds.provinces.ToSliceWithFilters(query, "")
-		sort.Slice(provinces, func(i, j int) bool {
-			return provinces[i].Name < provinces[j].Name
-		})
+		provinces := ds.filterProvinces(query, "")
+		sortItems(provinces, false, ds.provinceSortKey(SortByName))
 		if len(provinces) > limit {
 			provinces = provinces[:limit]
 		}
@@ -284,45 +703,65 @@ func (ds *DataService) GlobalSearch(query string, entity string, limit int) mode
 	}
 
 	if entity == "all" || entity == "ward" {
-		wards := ds.wards.ToSliceWithFilters(query, "", "")
-		sort.Slice(wards, func(i, j int) bool {
-			return wards[i].Name < wards[j].Name
-		})
+		wards := ds.filterWards(query, "", "")
+		sortItems(wards, false, ds.wardSortKey(SortByName))
 		if len(wards) > limit {
 			wards = wards[:limit]
 		}
 		result.Wards = wards
 	}
 
+	span.SetAttributes(
+		attribute.Int("search.provinces", len(result.Provinces)),
+		attribute.Int("search.wards", len(result.Wards)),
+	)
 	return result
 }
 
-// ValidateAddress validates if a ward belongs to a province
-func (ds *DataService) ValidateAddress(provinceCode, wardCode string) (*models.Ward, bool) {
+// ValidateAddress validates if a ward belongs to a province. Units given by
+// name are resolved as described by resolveProvince and resolveWard, and
+// have MatchedAlias set when designated through an alias.
+func (ds *DataService) ValidateAddress(ctx context.Context, address models.AddressQuery) (ward *models.Ward, province *models.Province, valid bool) {
+	_, span := tracing.Start(ctx, "DataService.ValidateAddress",
+		trace.WithAttributes(
+			attribute.String("province.code", address.ProvinceCode),
+			attribute.String("province.name", address.ProvinceName),
+			attribute.String("ward.code", address.WardCode),
+			attribute.String("ward.name", address.WardName),
+		))
+	defer func() {
+		span.SetAttributes(attribute.Bool("address.valid", valid))
+		span.End()
+	}()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
 	// Check if province exists
-	_, provinceExists := ds.provinces[provinceCode]
+	foundProvince, provinceExists := ds.resolveProvince(address.ProvinceCode, address.ProvinceName)
 	if !provinceExists {
-		return nil, false
+		return nil, nil, false
 	}
 
 	// Check if ward exists and belongs to the province
-	ward, wardExists := ds.wards[wardCode]
+	found, wardExists := ds.resolveWard(foundProvince.Code, address.WardCode, address.WardName)
 	if !wardExists {
-		return nil, false
+		return nil, nil, false
 	}
 
-	if ward.ParentCode != provinceCode {
-		return nil, false
+	if found.ParentCode != foundProvince.Code {
+		return nil, nil, false
 	}
 
-	return &ward, true
+	return &found, &foundProvince, true
 }
 
 // GetWardWithProvince returns ward with province information
-func (ds *DataService) GetWardWithProvince(wardCode string) (*models.Ward, *models.Province, error) {
+func (ds *DataService) GetWardWithProvince(ctx context.Context, wardCode string) (*models.Ward, *models.Province, error) {
+	_, span := tracing.Start(ctx, "DataService.GetWardWithProvince",
+		trace.WithAttributes(attribute.String("ward.code", wardCode)))
+	defer span.End()
+
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
 
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"vietnam-admin-api/geo"
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/tracing"
+)
+
+// ErrCentroidsUnavailable is returned by nearest ward searches when no ward
+// centroids are loaded
+var ErrCentroidsUnavailable = errors.New("ward centroids are not loaded")
+
+// centroidsFile is the optional centroid file of the data directory
+const centroidsFile = "centroids.json"
+
+// readCentroids reads the centroid file. A missing file means no centroids
+// are known.
+func (ds *DataService) readCentroids() ([]byte, models.Centroids, error) {
+	data, err := ds.readOptionalFile(centroidsFile)
+	if err != nil || data == nil {
+		return nil, models.Centroids{}, err
+	}
+	centroids, err := models.UnmarshalCentroids(data)
+	if err != nil {
+		return nil, models.Centroids{}, fmt.Errorf("failed to parse %s: %w", centroidsFile, err)
+	}
+	return data, centroids, nil
+}
+
+// applyCentroids sets the centroids of the loaded units and indexes those of
+// the wards. Invalid coordinates and centroids of unknown units are dropped
+// and logged.
+func applyCentroids(ctx context.Context, centroids models.Centroids, provinces models.ProvinceData, wards models.WardData) *geo.KDTree {
+	logger := logging.FromContext(ctx)
+
+	for code, c := range centroids.Provinces {
+		province, ok := provinces[code]
+		if !ok || !(geo.Point{Lat: c.Lat, Lng: c.Lng}).Valid() {
+			logger.Warn("ignoring centroid of province", "province", code, "lat", c.Lat, "lng", c.Lng)
+			continue
+		}
+		centroid := c
+		province.Centroid = &centroid
+		provinces[code] = province
+	}
+
+	items := make([]geo.Item, 0, len(centroids.Wards))
+	for code, c := range centroids.Wards {
+		ward, ok := wards[code]
+		point := geo.Point{Lat: c.Lat, Lng: c.Lng}
+		if !ok || !point.Valid() {
+			logger.Warn("ignoring centroid of ward", "ward", code, "lat", c.Lat, "lng", c.Lng)
+			continue
+		}
+		centroid := c
+		ward.Centroid = &centroid
+		wards[code] = ward
+		items = append(items, geo.Item{ID: code, Point: point})
+	}
+	return geo.NewKDTree(items)
+}
+
+// NearestWards returns up to limit wards whose centroids are closest to the
+// point, nearest first, with their provinces. Wards without a centroid are
+// never returned.
+func (ds *DataService) NearestWards(ctx context.Context, lat, lng float64, limit int) ([]models.NearestWard, error) {
+	_, span := tracing.Start(ctx, "DataService.NearestWards",
+		trace.WithAttributes(
+			attribute.Float64("geo.lat", lat),
+			attribute.Float64("geo.lng", lng),
+			attribute.Int("geo.limit", limit),
+		))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	if ds.wardCentroids.Len() == 0 {
+		return nil, ErrCentroidsUnavailable
+	}
+	neighbors := ds.wardCentroids.Nearest(geo.Point{Lat: lat, Lng: lng}, limit)
+	results := make([]models.NearestWard, len(neighbors))
+	for i, neighbor := range neighbors {
+		ward := ds.wards[neighbor.ID]
+		results[i] = models.NearestWard{Ward: ward, DistanceKm: neighbor.DistanceKm}
+		if province, ok := ds.provinces[ward.ParentCode]; ok {
+			results[i].Province = &province
+		}
+	}
+	return results, nil
+}
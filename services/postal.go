@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"vietnam-admin-api/logging"
+	"vietnam-admin-api/models"
+	"vietnam-admin-api/tracing"
+)
+
+// ErrUnknownPostalCode is returned for postal codes of no loaded province
+var ErrUnknownPostalCode = errors.New("unknown postal code")
+
+// postalFile is the optional postal code file of the data directory
+const postalFile = "postal.json"
+
+// postalIndex resolves postal codes to the units carrying them
+type postalIndex struct {
+	// provinces maps the prefix of a postal code to its province
+	provinces map[string]string
+	// wards maps exact postal codes to the wards carrying them
+	wards map[string][]string
+}
+
+// readPostalCodes reads the postal code file. A missing file means no
+// postal codes are known.
+func (ds *DataService) readPostalCodes() ([]byte, models.PostalCodes, error) {
+	data, err := ds.readOptionalFile(postalFile)
+	if err != nil || data == nil {
+		return nil, models.PostalCodes{}, err
+	}
+	postal, err := models.UnmarshalPostalCodes(data)
+	if err != nil {
+		return nil, models.PostalCodes{}, fmt.Errorf("failed to parse %s: %w", postalFile, err)
+	}
+	return data, postal, nil
+}
+
+// applyPostalCodes sets the postal codes of the loaded units and indexes
+// them. Invalid codes and codes of unknown units are dropped and logged.
+func applyPostalCodes(ctx context.Context, postal models.PostalCodes, provinces models.ProvinceData, wards models.WardData) postalIndex {
+	logger := logging.FromContext(ctx)
+	index := postalIndex{provinces: map[string]string{}, wards: map[string][]string{}}
+
+	// Provinces are visited in code order so that conflicts resolve the
+	// same way on every load
+	codes := make([]string, 0, len(provinces))
+	for code := range provinces {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		province := provinces[code]
+		province.PostalCodes = []string{}
+		for _, postalCode := range postal.Provinces[code] {
+			if !models.IsPostalCode(postalCode) {
+				logger.Warn("ignoring invalid postal code", "province", code, "postal_code", postalCode)
+				continue
+			}
+			prefix := models.PostalPrefix(postalCode)
+			if other, taken := index.provinces[prefix]; taken {
+				logger.Warn("ignoring postal code of another province", "province", code, "postal_code", postalCode, "owner", other)
+				continue
+			}
+			index.provinces[prefix] = code
+			province.PostalCodes = append(province.PostalCodes, postalCode)
+		}
+		provinces[code] = province
+	}
+	for code := range postal.Provinces {
+		if _, ok := provinces[code]; !ok {
+			logger.Warn("ignoring postal codes of unknown province", "province", code)
+		}
+	}
+
+	for code, postalCode := range postal.Wards {
+		ward, ok := wards[code]
+		if !ok || !models.IsPostalCode(postalCode) || index.provinces[models.PostalPrefix(postalCode)] != ward.ParentCode {
+			logger.Warn("ignoring postal code of ward", "ward", code, "postal_code", postalCode)
+			continue
+		}
+		ward.PostalCode = postalCode
+		wards[code] = ward
+		index.wards[postalCode] = append(index.wards[postalCode], code)
+	}
+	for _, wardCodes := range index.wards {
+		sort.Strings(wardCodes)
+	}
+	return index
+}
+
+// LookupPostalCode returns the province of a postal code, and the wards
+// whose own code it is
+func (ds *DataService) LookupPostalCode(ctx context.Context, postalCode string) (*models.PostalLookup, error) {
+	_, span := tracing.Start(ctx, "DataService.LookupPostalCode",
+		trace.WithAttributes(attribute.String("postal.code", postalCode)))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	provinceCode, ok := ds.postal.provinces[models.PostalPrefix(postalCode)]
+	if !ok || !models.IsPostalCode(postalCode) {
+		return nil, ErrUnknownPostalCode
+	}
+	province := ds.provinces[provinceCode]
+
+	lookup := &models.PostalLookup{
+		PostalCode: postalCode,
+		Province:   &province,
+		Wards:      make([]models.Ward, 0, len(ds.postal.wards[postalCode])),
+	}
+	for _, code := range ds.postal.wards[postalCode] {
+		lookup.Wards = append(lookup.Wards, ds.wards[code])
+	}
+	return lookup, nil
+}
+
+// GetWardPostal returns the postal information of a ward
+func (ds *DataService) GetWardPostal(ctx context.Context, wardCode string) (*models.WardPostal, error) {
+	_, span := tracing.Start(ctx, "DataService.GetWardPostal",
+		trace.WithAttributes(attribute.String("ward.code", wardCode)))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	ward, exists := ds.wards[wardCode]
+	if !exists {
+		return nil, fmt.Errorf("ward with code %s not found", wardCode)
+	}
+	return &models.WardPostal{
+		WardCode:            ward.Code,
+		PostalCode:          ward.PostalCode,
+		ProvincePostalCodes: ds.provinces[ward.ParentCode].PostalCodes,
+	}, nil
+}
+
+// MatchesPostalCode reports whether a postal code is valid for a ward: its
+// own code when known, otherwise any code of its province
+func (ds *DataService) MatchesPostalCode(ctx context.Context, wardCode, postalCode string) bool {
+	_, span := tracing.Start(ctx, "DataService.MatchesPostalCode",
+		trace.WithAttributes(
+			attribute.String("ward.code", wardCode),
+			attribute.String("postal.code", postalCode),
+		))
+	defer span.End()
+
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	ward, exists := ds.wards[wardCode]
+	if !exists || !models.IsPostalCode(postalCode) {
+		return false
+	}
+	if ward.PostalCode != "" {
+		return ward.PostalCode == postalCode
+	}
+	return ds.postal.provinces[models.PostalPrefix(postalCode)] == ward.ParentCode
+}
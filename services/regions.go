@@ -0,0 +1,73 @@
+package services
+
+import (
+	"sort"
+
+	"vietnam-admin-api/models"
+)
+
+// GetRegions returns every region and socio-economic region of the loaded
+// provinces, as accepted by the region filters
+func (ds *DataService) GetRegions() []string {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	regionMap := make(map[string]bool)
+	for _, province := range ds.provinces {
+		for _, region := range []string{province.Region, province.EconomicRegion} {
+			if region != "" {
+				regionMap[region] = true
+			}
+		}
+	}
+
+	regions := make([]string, 0, len(regionMap))
+	for region := range regionMap {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// IsRegion reports whether region is a region or socio-economic region of a
+// loaded province
+func (ds *DataService) IsRegion(region string) bool {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	for _, province := range ds.provinces {
+		if province.Region == region || province.EconomicRegion == region {
+			return true
+		}
+	}
+	return false
+}
+
+// provincesInRegion keeps the provinces in region; an empty region keeps all
+func provincesInRegion(provinces []models.Province, region string) []models.Province {
+	if region == "" {
+		return provinces
+	}
+	filtered := provinces[:0]
+	for _, province := range provinces {
+		if province.MatchesRegion(region) {
+			filtered = append(filtered, province)
+		}
+	}
+	return filtered
+}
+
+// wardsInRegion keeps the wards whose province is in region; an empty region
+// keeps all. The caller must hold the read lock.
+func (ds *DataService) wardsInRegion(wards []models.Ward, region string) []models.Ward {
+	if region == "" {
+		return wards
+	}
+	filtered := wards[:0]
+	for _, ward := range wards {
+		if ds.provinces[ward.ParentCode].MatchesRegion(region) {
+			filtered = append(filtered, ward)
+		}
+	}
+	return filtered
+}
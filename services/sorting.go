@@ -0,0 +1,113 @@
+package services
+
+import (
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// ErrInvalidSort is returned for sort parameters naming an unknown field
+var ErrInvalidSort = errors.New("invalid sort order")
+
+// Sortable fields of list endpoints
+const (
+	SortByName     = "name"
+	SortByCode     = "code"
+	SortByType     = "type"
+	SortByProvince = "province"
+)
+
+// SortOrder is a field to sort by and its direction
+type SortOrder struct {
+	Field string
+	Desc  bool
+}
+
+// DefaultSortOrder sorts alphabetically by name
+var DefaultSortOrder = SortOrder{Field: SortByName}
+
+// ParseSortOrder parses a sort parameter such as "name" or "-name". An empty
+// parameter selects DefaultSortOrder.
+func ParseSortOrder(s string) (SortOrder, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return DefaultSortOrder, nil
+	}
+
+	order := SortOrder{Field: strings.TrimPrefix(s, "-"), Desc: strings.HasPrefix(s, "-")}
+	switch order.Field {
+	case SortByName, SortByCode, SortByType, SortByProvince:
+		return order, nil
+	}
+	return SortOrder{}, ErrInvalidSort
+}
+
+// String returns the parameter form of the order, e.g. "-name"
+func (o SortOrder) String() string {
+	if o.Field == "" {
+		return DefaultSortOrder.String()
+	}
+	if o.Desc {
+		return "-" + o.Field
+	}
+	return o.Field
+}
+
+// sortKeySeparator joins compound sort keys. It orders before every character
+// used in keys, so a shorter first component always sorts first.
+const sortKeySeparator = " "
+
+// collationKeys computes Vietnamese collation keys for names. Byte order
+// misplaces letters such as "Đ" and tone-marked vowels; comparing the keys
+// gives dictionary order instead. The keys are hex encoded so they can be
+// compared as plain strings and carried in pagination cursors.
+type collationKeys struct {
+	collator *collate.Collator
+	buf      collate.Buffer
+}
+
+func newCollationKeys() *collationKeys {
+	return &collationKeys{collator: collate.New(language.Vietnamese)}
+}
+
+func (ck *collationKeys) key(name string) string {
+	key := hex.EncodeToString(ck.collator.KeyFromString(&ck.buf, name))
+	ck.buf.Reset()
+	return key
+}
+
+// sortItems sorts items by the key returned by keyOf, ascending or
+// descending, then by code ascending. paginate relies on the same order.
+func sortItems[T any](items []T, desc bool, keyOf sortKeyFunc[T]) {
+	type keyed struct {
+		key, code string
+		item      T
+	}
+
+	entries := make([]keyed, len(items))
+	for i, item := range items {
+		key, code := keyOf(item)
+		entries[i] = keyed{key: key, code: code, item: item}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return sortsBefore(entries[i].key, entries[i].code, entries[j].key, entries[j].code, desc)
+	})
+
+	for i := range entries {
+		items[i] = entries[i].item
+	}
+}
+
+// sortsBefore reports whether the item with (key, code) orders before the
+// item with (otherKey, otherCode)
+func sortsBefore(key, code, otherKey, otherCode string, desc bool) bool {
+	if key != otherKey {
+		return (key < otherKey) != desc
+	}
+	return code < otherCode
+}